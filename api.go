@@ -0,0 +1,173 @@
+package goproxmox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run cmd/pvegen/main.go -schema cmd/pvegen/apidoc.json -endpoints cmd/pvegen/endpoints.txt -out api_gen.go
+
+// API provides typed access to the endpoints listed in cmd/pvegen/endpoints.txt, generated from the API schema
+// in cmd/pvegen/apidoc.json.
+// Its methods are generated by cmd/pvegen and named after the HTTP method and the path, e.g.
+// PostNodesQemuStatusStart for POST /nodes/{node}/qemu/{vmid}/status/start.
+// Required parameters are method arguments, optional ones are passed in the Params struct of the method.
+type API struct {
+	client *Client
+}
+
+// newAPIRequest creates a request of a generated API method. Parameters of GET and DELETE requests are sent
+// in the query string.
+func (c *Client) newAPIRequest(ctx context.Context, method, path string, params url.Values) (*http.Request, error) {
+	if (method == http.MethodGet || method == http.MethodDelete) && len(params) > 0 {
+		path += "?" + params.Encode()
+		params = nil
+	}
+
+	req, err := c.newFormRequest(method, path, params)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
+// indexedKey splits an API key like net0 into the name and the index.
+var indexedKey = regexp.MustCompile(`^(.*?)(\d+)$`)
+
+// setParams sets the fields of the generated Params struct p from values in the form the API sends them, e.g.
+// the options map of a VMConfig. Keys like net0 are set in the map of the indexed parameter net[n].
+func setParams(p interface{}, values map[string]string) error {
+	fields := make(map[string]reflect.Value)
+	v := reflect.ValueOf(p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if name := v.Type().Field(i).Tag.Get("api"); name != "" {
+			fields[name] = v.Field(i)
+		}
+	}
+
+	for key, value := range values {
+		field, ok := fields[key]
+		index := -1
+		if !ok {
+			if m := indexedKey.FindStringSubmatch(key); m != nil {
+				field, ok = fields[m[1]+"[n]"]
+				index, _ = strconv.Atoi(m[2])
+			}
+		}
+		if !ok {
+			return NewArgError(key, "is not a parameter of the API")
+		}
+
+		t := field.Type()
+		switch t.Kind() {
+		case reflect.Map, reflect.Ptr:
+			t = t.Elem()
+		}
+		parsed := reflect.New(t).Elem()
+		switch t.Kind() {
+		case reflect.String:
+			parsed.SetString(value)
+		case reflect.Int:
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return NewArgError(key, fmt.Sprintf("%q is not an integer", value))
+			}
+			parsed.SetInt(int64(i))
+		case reflect.Float64:
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return NewArgError(key, fmt.Sprintf("%q is not a number", value))
+			}
+			parsed.SetFloat(f)
+		case reflect.Bool:
+			switch strings.ToLower(value) {
+			case "1", "yes", "on", "true":
+				parsed.SetBool(true)
+			case "0", "no", "off", "false":
+			default:
+				return NewArgError(key, fmt.Sprintf("%q is not a boolean", value))
+			}
+		case reflect.Slice:
+			parsed = reflect.ValueOf(strings.Split(value, ","))
+		}
+
+		switch field.Kind() {
+		case reflect.Map:
+			if field.IsNil() {
+				field.Set(reflect.MakeMap(field.Type()))
+			}
+			field.SetMapIndex(reflect.ValueOf(index), parsed)
+		case reflect.Ptr:
+			ptr := reflect.New(t)
+			ptr.Elem().Set(parsed)
+			field.Set(ptr)
+		default:
+			field.Set(parsed)
+		}
+	}
+	return nil
+}
+
+// unmarshalIndexed decodes the keys like net0 of the JSON object data into the maps of the indexed properties.
+// targets maps the name without index, e.g. net, to a pointer to the map.
+func unmarshalIndexed(data []byte, targets map[string]interface{}) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for key, value := range raw {
+		m := indexedKey.FindStringSubmatch(key)
+		if m == nil {
+			continue
+		}
+		target, ok := targets[m[1]]
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(m[2])
+		if err != nil {
+			return err
+		}
+		field := reflect.ValueOf(target).Elem()
+		if field.IsNil() {
+			field.Set(reflect.MakeMap(field.Type()))
+		}
+		element := reflect.New(field.Type().Elem())
+		if err := json.Unmarshal(value, element.Interface()); err != nil {
+			return fmt.Errorf("cannot unmarshal %s: %v", key, err)
+		}
+		field.SetMapIndex(reflect.ValueOf(index), element.Elem())
+	}
+	return nil
+}
+
+// IntBool is a boolean the API encodes as 0 or 1.
+type IntBool bool
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b IntBool) MarshalJSON() ([]byte, error) {
+	if b {
+		return []byte("1"), nil
+	}
+	return []byte("0"), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts 0/1 and true/false, also as strings.
+func (b *IntBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "1", "true":
+		*b = true
+	case "0", "false", "", "null":
+		*b = false
+	default:
+		return fmt.Errorf("cannot unmarshal %s into IntBool", data)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package goproxmox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	minVMID = 100
+	maxVMID = 999999999
+)
+
+type ClusterService interface {
+	NextVMID(ctx context.Context, vmIDRange *VMIDRange) (int, error)
+	FindVM(ctx context.Context, vmID int) (*VMLocation, error)
+	FindVMsByName(ctx context.Context, name string) ([]VMLocation, error)
+	FindVMsByTag(ctx context.Context, tag string) ([]VMLocation, error)
+	SetResourceCacheTTL(ttl time.Duration)
+	InvalidateResourceCache()
+}
+
+type ClusterServiceOp struct {
+	client *Client
+
+	// cached guest resources, see SetResourceCacheTTL
+	mu          sync.Mutex
+	cacheTTL    time.Duration
+	cachedAt    time.Time
+	cachedGuest []GetClusterResourcesItem
+}
+
+var _ ClusterService = &ClusterServiceOp{}
+
+// VMLocation is a guest (QEMU VM or LXC container) as listed in the cluster resources.
+type VMLocation struct {
+	VMID     int
+	Name     string
+	Node     string
+	Type     string // "qemu" or "lxc"
+	Status   string
+	Tags     []string
+	Template bool
+}
+
+func newVMLocation(resource GetClusterResourcesItem) VMLocation {
+	return VMLocation{
+		VMID:     int(resource.VMID),
+		Name:     resource.Name,
+		Node:     resource.Node,
+		Type:     resource.Type,
+		Status:   resource.Status,
+		Tags:     parseTags(resource.Tags),
+		Template: bool(resource.Template),
+	}
+}
+
+// parseTags splits the tags of a guest. PVE separates them with ';', older versions also with ',' or spaces.
+func parseTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ';' || r == ',' || r == ' '
+	})
+}
+
+// VMIDRange limits the VMIDs NextVMID may return. A zero bound is unlimited.
+type VMIDRange struct {
+	Min int
+	Max int
+}
+
+func (r *VMIDRange) bounds() (int, int, error) {
+	lower, upper := minVMID, maxVMID
+	if r.Min != 0 {
+		lower = r.Min
+	}
+	if r.Max != 0 {
+		upper = r.Max
+	}
+	if lower < minVMID || upper > maxVMID || lower > upper {
+		return 0, 0, NewArgError("vmIDRange", fmt.Sprintf("%d-%d is not within %d-%d", lower, upper, minVMID, maxVMID))
+	}
+	return lower, upper, nil
+}
+
+// Get the next free VMID of the cluster. Without a range the VMID suggested by the cluster is returned.
+// With a range the lowest VMID in the range that is not used by a guest is returned. The returned VMID is
+// only free at the time of the check, use QemuService.CreateVMAuto to create a VM without races.
+func (s *ClusterServiceOp) NextVMID(ctx context.Context, vmIDRange *VMIDRange) (int, error) {
+	if vmIDRange == nil {
+		return s.client.API.GetClusterNextID(ctx, nil)
+	}
+	lower, upper, err := vmIDRange.bounds()
+	if err != nil {
+		return 0, err
+	}
+
+	vmID, err := s.client.API.GetClusterNextID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if vmID >= lower && vmID <= upper {
+		return vmID, nil
+	}
+
+	resourceType := GetClusterResourcesType_VM
+	resources, err := s.client.API.GetClusterResources(ctx, &GetClusterResourcesParams{Type: &resourceType})
+	if err != nil {
+		return 0, err
+	}
+	used := make(map[int]bool)
+	for _, resource := range resources {
+		used[int(resource.VMID)] = true
+	}
+
+	for candidate := lower; candidate <= upper; candidate++ {
+		if used[candidate] {
+			continue
+		}
+		// the cluster also knows about IDs that are reserved but not yet listed as resource
+		vmID, err := s.client.API.GetClusterNextID(ctx, &GetClusterNextIDParams{VMID: Int(candidate)})
+		if _, ok := err.(*VMAlreadyExistsError); ok {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return vmID, nil
+	}
+	return 0, fmt.Errorf("no free VMID in range %d-%d", lower, upper)
+}
+
+// Find the guest with the given VMID anywhere in the cluster. Fails with a VMDoesNotExistError if there is none.
+func (s *ClusterServiceOp) FindVM(ctx context.Context, vmID int) (*VMLocation, error) {
+	guests, err := s.guests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, guest := range guests {
+		if int(guest.VMID) == vmID {
+			location := newVMLocation(guest)
+			return &location, nil
+		}
+	}
+	return nil, &VMDoesNotExistError{strconv.Itoa(vmID)}
+}
+
+// Find all guests with the given name, sorted by VMID. Names are not unique in a cluster.
+func (s *ClusterServiceOp) FindVMsByName(ctx context.Context, name string) ([]VMLocation, error) {
+	return s.findVMs(ctx, func(location VMLocation) bool {
+		return location.Name == name
+	})
+}
+
+// Find all guests with the given tag, sorted by VMID.
+func (s *ClusterServiceOp) FindVMsByTag(ctx context.Context, tag string) ([]VMLocation, error) {
+	return s.findVMs(ctx, func(location VMLocation) bool {
+		for _, t := range location.Tags {
+			if t == tag {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *ClusterServiceOp) findVMs(ctx context.Context, match func(VMLocation) bool) ([]VMLocation, error) {
+	guests, err := s.guests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	locations := make([]VMLocation, 0)
+	for _, guest := range guests {
+		if location := newVMLocation(guest); match(location) {
+			locations = append(locations, location)
+		}
+	}
+	sort.Slice(locations, func(i, j int) bool { return locations[i].VMID < locations[j].VMID })
+	return locations, nil
+}
+
+// Cache the guest list used by the Find methods and the ByID methods of QemuService for ttl. Lookups then
+// may return a stale node or status; the ByID methods resolve the node again if the VM is not found
+// where the cache expects it. The cache is disabled by default (ttl 0).
+func (s *ClusterServiceOp) SetResourceCacheTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cacheTTL = ttl
+	s.cachedGuest = nil
+}
+
+// Drop the cached guest list, e.g. after a migration.
+func (s *ClusterServiceOp) InvalidateResourceCache() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cachedGuest = nil
+}
+
+func (s *ClusterServiceOp) guests(ctx context.Context) ([]GetClusterResourcesItem, error) {
+	s.mu.Lock()
+	if s.cachedGuest != nil && time.Since(s.cachedAt) < s.cacheTTL {
+		guests := s.cachedGuest
+		s.mu.Unlock()
+		return guests, nil
+	}
+	s.mu.Unlock()
+
+	resourceType := GetClusterResourcesType_VM
+	guests, err := s.client.API.GetClusterResources(ctx, &GetClusterResourcesParams{Type: &resourceType})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cacheTTL > 0 {
+		s.cachedGuest = guests
+		s.cachedAt = time.Now()
+	}
+	return guests, nil
+}
@@ -0,0 +1,70 @@
+package goproxmox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestNextVMID(t *testing.T) {
+	tests := []struct {
+		name      string
+		suggested int
+		used      []int
+		reserved  []int // known to the cluster but not listed as resource
+		vmIDRange *VMIDRange
+		vmID      int
+		err       bool
+	}{
+		{name: "no range", suggested: 104, vmID: 104},
+		{name: "suggestion in range", suggested: 104, vmIDRange: &VMIDRange{Min: 100, Max: 200}, vmID: 104},
+		{name: "lower bound only", suggested: 104, vmIDRange: &VMIDRange{Min: 1000}, vmID: 1000},
+		{name: "used and reserved skipped", suggested: 104, used: []int{200, 201}, reserved: []int{202}, vmIDRange: &VMIDRange{Min: 200, Max: 299}, vmID: 203},
+		{name: "range full", suggested: 104, used: []int{200, 201}, reserved: []int{202}, vmIDRange: &VMIDRange{Min: 200, Max: 202}, err: true},
+		{name: "below minimum", suggested: 104, vmIDRange: &VMIDRange{Min: 50, Max: 150}, err: true},
+		{name: "empty range", suggested: 104, vmIDRange: &VMIDRange{Min: 300, Max: 200}, err: true},
+	}
+
+	for _, test := range tests {
+		reserved := make(map[string]bool)
+		for _, vmID := range append(test.used, test.reserved...) {
+			reserved[strconv.Itoa(vmID)] = true
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api2/json/cluster/nextid", func(w http.ResponseWriter, r *http.Request) {
+			vmID := r.URL.Query().Get("vmid")
+			if vmID == "" {
+				vmID = strconv.Itoa(test.suggested)
+			}
+			if reserved[vmID] {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, `{"data":null,"errors":{"vmid":"VM %s already exists"}}`, vmID)
+				return
+			}
+			fmt.Fprintf(w, `{"data":"%s"}`, vmID)
+		})
+		mux.HandleFunc("/api2/json/cluster/resources", func(w http.ResponseWriter, r *http.Request) {
+			resources := "["
+			for i, vmID := range test.used {
+				if i > 0 {
+					resources += ","
+				}
+				resources += fmt.Sprintf(`{"id":"qemu/%d","type":"qemu","vmid":%d,"node":"pve1"}`, vmID, vmID)
+			}
+			fmt.Fprintf(w, `{"data":%s]}`, resources)
+		})
+		client, teardown := setup(mux)
+
+		vmID, err := client.Cluster.NextVMID(context.Background(), test.vmIDRange)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: got VMID %d, want an error", test.name, vmID)
+			}
+		} else if err != nil || vmID != test.vmID {
+			t.Errorf("%s: got VMID %d, %v, want %d", test.name, vmID, err, test.vmID)
+		}
+		teardown()
+	}
+}
@@ -0,0 +1,1013 @@
+// Command pvegen generates typed bindings for the Proxmox VE API from the API schema the PVE API viewer
+// is built from. The schema is either the apidoc.js shipped by pve-docs or the same array as plain JSON.
+//
+// Usage:
+//
+//	pvegen -schema apidoc.js -out api_gen.go [-package goproxmox] [-endpoints endpoints.txt] [-paths /cluster]
+//
+// For every path and HTTP method pvegen emits a method on API, a Params struct for the optional parameters,
+// enum types for parameters with a fixed set of values and response types. Required parameters become
+// method arguments. Descriptions and constraints (minimum, maximum, length, pattern, format and default)
+// are kept in the doc comments and the constraints are checked before a request is sent. Indexed
+// parameters like net[n] become maps from the index to the value.
+//
+// The endpoints file limits the generated endpoints to those it lists as "METHOD /path", one per line, so
+// that the complete apidoc.js can be used as schema.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// schemaNode is a node of the API tree.
+type schemaNode struct {
+	Path     string                  `json:"path"`
+	Text     string                  `json:"text"`
+	Info     map[string]*apiEndpoint `json:"info"`
+	Children []*schemaNode           `json:"children"`
+}
+
+// apiEndpoint describes one HTTP method of a path.
+type apiEndpoint struct {
+	Method      string      `json:"method"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Parameters  *typeSchema `json:"parameters"`
+	Returns     *typeSchema `json:"returns"`
+}
+
+// typeSchema is the JSON schema dialect of PVE::JSONSchema.
+type typeSchema struct {
+	Type        string                 `json:"type"`
+	Description string                 `json:"description"`
+	Optional    flexBool               `json:"optional"`
+	Format      interface{}            `json:"format"`
+	Enum        []string               `json:"enum"`
+	Minimum     *float64               `json:"minimum"`
+	Maximum     *float64               `json:"maximum"`
+	MaxLength   *int                   `json:"maxLength"`
+	Pattern     string                 `json:"pattern"`
+	Default     interface{}            `json:"default"`
+	Properties  map[string]*typeSchema `json:"properties"`
+	Items       *typeSchema            `json:"items"`
+}
+
+// flexBool accepts the 0/1 and true/false booleans found in the schema.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "1", "true":
+		*b = true
+	default:
+		*b = false
+	}
+	return nil
+}
+
+var (
+	schemaFile  = flag.String("schema", "cmd/pvegen/apidoc.json", "API schema, either apidoc.js or JSON")
+	outFile     = flag.String("out", "api_gen.go", "output file")
+	packageName = flag.String("package", "goproxmox", "package name of the generated file")
+	paths       = flag.String("paths", "", "comma separated list of paths to generate, including the paths below them (default all)")
+	endpoints   = flag.String("endpoints", "", "file listing the endpoints to generate as \"METHOD /path\" per line (default all)")
+)
+
+var httpMethods = []string{"GET", "POST", "PUT", "DELETE"}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("pvegen: ")
+	flag.Parse()
+
+	raw, err := ioutil.ReadFile(*schemaFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tree, err := parseSchema(raw)
+	if err != nil {
+		log.Fatalf("%s: %v", *schemaFile, err)
+	}
+
+	g := &generator{
+		imports:  make(map[string]bool),
+		names:    make(map[string]string),
+		patterns: make(map[string]string),
+	}
+	var filters []string
+	if *paths != "" {
+		filters = strings.Split(*paths, ",")
+	}
+	var selected map[string]bool
+	if *endpoints != "" {
+		if selected, err = readEndpoints(*endpoints); err != nil {
+			log.Fatal(err)
+		}
+	}
+	walk(tree, func(node *schemaNode) {
+		if !includePath(node.Path, filters) {
+			return
+		}
+		for _, method := range httpMethods {
+			endpoint, ok := node.Info[method]
+			if !ok || selected != nil && !selected[method+" "+node.Path] {
+				continue
+			}
+			delete(selected, method+" "+node.Path)
+			g.endpoint(node.Path, method, endpoint)
+		}
+	})
+	for missing := range selected {
+		log.Fatalf("%s: %s is not in the schema %s", *endpoints, missing, *schemaFile)
+	}
+
+	src, err := format.Source(g.file(*schemaFile))
+	if err != nil {
+		log.Fatalf("formatting generated code: %v", err)
+	}
+	if err := ioutil.WriteFile(*outFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseSchema reads the API tree. apidoc.js assigns the array to a variable, so everything outside of the
+// outermost brackets is dropped.
+func parseSchema(raw []byte) ([]*schemaNode, error) {
+	start, end := bytes.IndexByte(raw, '['), bytes.LastIndexByte(raw, ']')
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no API tree found")
+	}
+	var tree []*schemaNode
+	if err := json.Unmarshal(raw[start:end+1], &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// readEndpoints reads the "METHOD /path" lines of an endpoints file. Empty lines and lines starting with #
+// are skipped.
+func readEndpoints(filename string) (map[string]bool, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	selected := make(map[string]bool)
+	for i, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+			return nil, fmt.Errorf("%s:%d: expected \"METHOD /path\", got %q", filename, i+1, line)
+		}
+		selected[strings.ToUpper(fields[0])+" "+fields[1]] = true
+	}
+	return selected, nil
+}
+
+func walk(nodes []*schemaNode, fn func(*schemaNode)) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Path < nodes[j].Path })
+	for _, node := range nodes {
+		fn(node)
+		walk(node.Children, fn)
+	}
+}
+
+func includePath(path string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		filter = strings.TrimSuffix(strings.TrimSpace(filter), "/")
+		if path == filter || strings.HasPrefix(path, filter+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// generator collects the generated declarations.
+type generator struct {
+	buf     bytes.Buffer
+	imports map[string]bool
+
+	// Generated type and method names mapped to the path they were generated for.
+	names map[string]string
+
+	// Compiled patterns by their variable name.
+	patterns map[string]string
+}
+
+func (g *generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+	g.buf.WriteByte('\n')
+}
+
+func (g *generator) file(source string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by pvegen from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buf, "package %s\n\n", *packageName)
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	buf.WriteString("import (\n")
+	for _, imp := range imports {
+		fmt.Fprintf(&buf, "\t%q\n", imp)
+	}
+	buf.WriteString(")\n")
+	if len(g.patterns) > 0 {
+		buf.WriteString("\nvar (\n")
+		names := make([]string, 0, len(g.patterns))
+		for name := range g.patterns {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&buf, "\t%s = regexp.MustCompile(%s)\n", name, backquote(g.patterns[name]))
+		}
+		buf.WriteString(")\n")
+	}
+	buf.WriteString("\n")
+	buf.Write(g.buf.Bytes())
+	return buf.Bytes()
+}
+
+func (g *generator) declare(name, path string) {
+	if other, ok := g.names[name]; ok {
+		log.Fatalf("%s: name %s is already used for %s", path, name, other)
+	}
+	g.names[name] = path
+}
+
+// parameter is a parameter of an endpoint.
+type parameter struct {
+	Name   string // name in the API
+	GoName string // exported Go name
+	Arg    string // Go name as method argument
+	GoType string // type of the value, the element type for indexed parameters
+	Schema *typeSchema
+	Enum   bool
+
+	// Indexed parameters like net[n] are sent as net0, net1 and so on. Base is the name without "[n]".
+	Indexed bool
+	Base    string
+
+	// Package variable of the compiled pattern, if the parameter has one Go can handle.
+	PatternVar string
+}
+
+// reservedArgs are identifiers used in the generated method bodies.
+var reservedArgs = map[string]bool{
+	"a": true, "ctx": true, "params": true, "path": true, "body": true, "req": true, "err": true, "root": true,
+	"k": true, "v": true, "url": true, "http": true, "fmt": true, "strconv": true,
+}
+
+func (g *generator) endpoint(path, httpMethod string, endpoint *apiEndpoint) {
+	name := methodName(httpMethod, path)
+	g.declare(name, path)
+
+	properties := map[string]*typeSchema{}
+	if endpoint.Parameters != nil && endpoint.Parameters.Properties != nil {
+		properties = endpoint.Parameters.Properties
+	}
+
+	// path parameters in the order they appear in the path
+	var pathParams []*parameter
+	pathFormat := strings.TrimPrefix(path, "/")
+	for _, segment := range strings.Split(pathFormat, "/") {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+		paramName := strings.Trim(segment, "{}")
+		schema := properties[paramName]
+		if schema == nil {
+			schema = &typeSchema{Type: "string"}
+		}
+		param := g.newParameter(name, paramName, schema)
+		param.GoType = scalarGoType(schema.Type)
+		verb := "%s"
+		if param.GoType == "int" {
+			verb = "%d"
+		}
+		pathFormat = strings.Replace(pathFormat, segment, verb, 1)
+		pathParams = append(pathParams, param)
+	}
+	isPathParam := func(name string) bool {
+		for _, param := range pathParams {
+			if param.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	var required, optional []*parameter
+	for _, paramName := range sortedKeys(properties) {
+		if isPathParam(paramName) {
+			continue
+		}
+		param := g.newParameter(name, paramName, properties[paramName])
+		if param.Indexed && !bool(param.Schema.Optional) {
+			log.Fatalf("%s %s: required indexed parameter %s is not supported", httpMethod, path, paramName)
+		}
+		if param.Schema.Optional {
+			optional = append(optional, param)
+		} else {
+			required = append(required, param)
+		}
+	}
+	for _, param := range append(append([]*parameter{}, required...), optional...) {
+		if param.Enum {
+			g.enum(param.GoType, fmt.Sprintf("the %s parameter of %s", param.Name, name), param.Schema.Enum, path)
+		}
+	}
+
+	paramsType := name + "Params"
+	if len(optional) > 0 {
+		g.params(paramsType, name, path, optional)
+	}
+
+	ret := g.returnType(name, path, endpoint.Returns)
+
+	// method
+	g.imports["context"] = true
+	g.imports["net/http"] = true
+	g.p("// %s calls %s %s.", name, httpMethod, path)
+	if endpoint.Description != "" {
+		g.p("//")
+		g.comment("", endpoint.Description)
+	}
+	if ret.Doc != "" {
+		doc := ret.Doc
+		if !strings.HasPrefix(strings.ToLower(doc), "return") {
+			doc = "Returns " + lowerFirst(doc)
+		}
+		g.p("//")
+		g.comment("", doc)
+	}
+	args := []string{"ctx context.Context"}
+	for _, param := range append(append([]*parameter{}, pathParams...), required...) {
+		args = append(args, param.Arg+" "+param.GoType)
+	}
+	if len(optional) > 0 {
+		args = append(args, "params *"+paramsType)
+	}
+	results := "error"
+	errReturn := func(err string) string { return "return " + err }
+	if ret.GoType != "" {
+		results = "(" + ret.GoType + ", error)"
+		errReturn = func(err string) string { return "return " + ret.Zero + ", " + err }
+	}
+	builder := "new" + name + "Request"
+	callArgs := []string{"ctx"}
+	for _, param := range append(append([]*parameter{}, pathParams...), required...) {
+		callArgs = append(callArgs, param.Arg)
+	}
+	if len(optional) > 0 {
+		callArgs = append(callArgs, "params")
+	}
+	g.p("func (a *API) %s(%s) %s {", name, strings.Join(args, ", "), results)
+	g.p("req, err := a.%s(%s)", builder, strings.Join(callArgs, ", "))
+	g.p("if err != nil {")
+	g.p("%s", errReturn("err"))
+	g.p("}")
+	if ret.GoType == "" {
+		g.p("_, err = a.client.Do(req, nil)")
+		g.p("return err")
+	} else {
+		g.p("")
+		g.p("root := new(struct {")
+		g.p("Data %s `json:\"data\"`", ret.RootType)
+		g.p("})")
+		g.p("if _, err = a.client.Do(req, root); err != nil {")
+		g.p("%s", errReturn("err"))
+		g.p("}")
+		g.p("")
+		g.p("return %s, nil", ret.Result)
+	}
+	g.p("}")
+	g.p("")
+
+	// request builder, also used by the services that decode the response themselves
+	g.declare(builder, path)
+	errReturn = func(err string) string { return "return nil, " + err }
+	g.p("// %s validates the parameters and builds the request of %s.", builder, name)
+	g.p("func (a *API) %s(%s) (*http.Request, error) {", builder, strings.Join(args, ", "))
+
+	if len(pathParams) == 0 {
+		g.p("path := %q", pathFormat)
+	} else {
+		g.imports["fmt"] = true
+		pathArgs := make([]string, len(pathParams))
+		for i, param := range pathParams {
+			pathArgs[i] = param.Arg
+			if param.GoType == "string" {
+				g.imports["net/url"] = true
+				pathArgs[i] = "url.PathEscape(" + param.Arg + ")"
+			}
+		}
+		g.p("path := fmt.Sprintf(%q, %s)", pathFormat, strings.Join(pathArgs, ", "))
+	}
+
+	g.imports["net/url"] = true
+	g.p("body := url.Values{}")
+	for _, param := range required {
+		g.checks(param, param.Arg, errReturn)
+		g.setValue("body", param, param.Arg)
+	}
+	if len(optional) > 0 {
+		g.p("if params != nil {")
+		g.p("if err := params.Validate(); err != nil {")
+		g.p("%s", errReturn("err"))
+		g.p("}")
+		g.p("for k, v := range params.values() {")
+		g.p("body[k] = v")
+		g.p("}")
+		g.p("}")
+	}
+	g.p("")
+	g.p("return a.client.newAPIRequest(ctx, http.Method%s, path, body)", strings.Title(strings.ToLower(httpMethod)))
+	g.p("}")
+	g.p("")
+}
+
+func (g *generator) newParameter(method, name string, schema *typeSchema) *parameter {
+	param := &parameter{
+		Name:   name,
+		GoName: goName(name),
+		Schema: schema,
+		GoType: scalarGoType(schema.Type),
+	}
+	if strings.HasSuffix(name, "[n]") {
+		param.Indexed = true
+		param.Base = strings.TrimSuffix(name, "[n]")
+	}
+	param.Arg = lowerCamel(name)
+	if token.Lookup(param.Arg).IsKeyword() || reservedArgs[param.Arg] {
+		param.Arg += "Arg"
+	}
+	if schema.Type == "array" {
+		// list parameters are sent as repeated form values
+		param.GoType = "[]string"
+	}
+	if len(schema.Enum) > 0 && param.GoType == "string" {
+		param.Enum = true
+		param.GoType = method + param.GoName
+	}
+	if re := compilePattern(schema.Pattern); re != nil && !param.Enum {
+		param.PatternVar = "pattern" + method + param.GoName
+		g.patterns[param.PatternVar] = re.String()
+	}
+	return param
+}
+
+// enum writes a string type with one constant per value.
+func (g *generator) enum(typeName, doc string, values []string, path string) {
+	g.declare(typeName, path)
+	g.p("// %s is %s.", typeName, doc)
+	g.p("type %s string", typeName)
+	g.p("")
+	g.p("const (")
+	for _, value := range values {
+		constName := typeName + "_" + goName(value)
+		g.declare(constName, path)
+		g.p("%s %s = %q", constName, typeName, value)
+	}
+	g.p(")")
+	g.p("")
+	g.p("// IsKnown reports whether m is one of the values listed in the API schema.")
+	g.p("func (m %s) IsKnown() bool {", typeName)
+	g.p("switch m {")
+	consts := make([]string, len(values))
+	for i, value := range values {
+		consts[i] = typeName + "_" + goName(value)
+	}
+	g.p("case %s:", strings.Join(consts, ", "))
+	g.p("return true")
+	g.p("}")
+	g.p("return false")
+	g.p("}")
+	g.p("")
+}
+
+// params writes the struct of the optional parameters together with its Validate and values methods.
+func (g *generator) params(typeName, method, path string, params []*parameter) {
+	g.declare(typeName, path)
+	g.p("// %s are the optional parameters of %s.", typeName, method)
+	g.p("type %s struct {", typeName)
+	for i, param := range params {
+		if i > 0 {
+			g.p("")
+		}
+		g.parameterDoc(param)
+		switch {
+		case param.Indexed:
+			g.p("%s map[int]%s `api:%q`", param.GoName, param.GoType, param.Name)
+		case param.GoType == "[]string":
+			g.p("%s %s `api:%q`", param.GoName, param.GoType, param.Name)
+		default:
+			g.p("%s *%s `api:%q`", param.GoName, param.GoType, param.Name)
+		}
+	}
+	g.p("}")
+	g.p("")
+
+	g.p("// Validate checks the parameters against the constraints of the API schema.")
+	g.p("func (p *%s) Validate() error {", typeName)
+	for _, param := range params {
+		if !g.hasChecks(param) {
+			continue
+		}
+		if param.Indexed {
+			g.p("for n, v := range p.%s {", param.GoName)
+		} else {
+			g.p("if p.%s != nil {", param.GoName)
+			g.p("v := *p.%s", param.GoName)
+		}
+		g.checks(param, "v", func(err string) string { return "return " + err })
+		g.p("}")
+	}
+	g.p("return nil")
+	g.p("}")
+	g.p("")
+
+	g.imports["net/url"] = true
+	g.p("func (p *%s) values() url.Values {", typeName)
+	g.p("values := url.Values{}")
+	for _, param := range params {
+		if param.Indexed {
+			g.imports["fmt"] = true
+			g.p("for n, v := range p.%s {", param.GoName)
+			g.p("values.Set(fmt.Sprintf(%q, n), %s)", param.Base+"%d", g.encode(param, "v"))
+			g.p("}")
+			continue
+		}
+		g.p("if p.%s != nil {", param.GoName)
+		if param.GoType == "[]string" {
+			g.setValue("values", param, "p."+param.GoName)
+		} else {
+			g.setValue("values", param, "*p."+param.GoName)
+		}
+		g.p("}")
+	}
+	g.p("return values")
+	g.p("}")
+	g.p("")
+}
+
+func (g *generator) parameterDoc(param *parameter) {
+	schema := param.Schema
+	if schema.Description != "" {
+		g.comment("", schema.Description)
+	}
+	var constraints []string
+	if schema.Minimum != nil {
+		constraints = append(constraints, "Minimum: "+formatNumber(*schema.Minimum)+".")
+	}
+	if schema.Maximum != nil {
+		constraints = append(constraints, "Maximum: "+formatNumber(*schema.Maximum)+".")
+	}
+	if schema.MaxLength != nil {
+		constraints = append(constraints, fmt.Sprintf("Maximum length: %d.", *schema.MaxLength))
+	}
+	if format, ok := schema.Format.(string); ok && format != "" {
+		constraints = append(constraints, "Format: "+format+".")
+	}
+	if schema.Pattern != "" {
+		constraints = append(constraints, "Pattern: "+schema.Pattern)
+	}
+	if schema.Default != nil {
+		constraints = append(constraints, fmt.Sprintf("Default: %v.", schema.Default))
+	}
+	if param.Indexed {
+		constraints = append(constraints, fmt.Sprintf("Sent as %s0, %s1 and so on for the keys of the map.", param.Base, param.Base))
+	}
+	if len(constraints) > 0 {
+		if schema.Description != "" {
+			g.p("//")
+		}
+		for _, constraint := range constraints {
+			g.p("// %s", constraint)
+		}
+	}
+}
+
+func (g *generator) hasChecks(param *parameter) bool {
+	schema := param.Schema
+	switch param.GoType {
+	case "int", "float64":
+		return schema.Minimum != nil || schema.Maximum != nil
+	case "bool", "[]string":
+		return false
+	}
+	return param.Enum || schema.MaxLength != nil || param.PatternVar != ""
+}
+
+// checks writes the validation of the value expr of param.
+func (g *generator) checks(param *parameter, expr string, errReturn func(string) string) {
+	schema := param.Schema
+	argName := strconv.Quote(param.Name)
+	if param.Indexed {
+		g.imports["fmt"] = true
+		argName = fmt.Sprintf("fmt.Sprintf(%q, n)", param.Base+"%d")
+	}
+	fail := func(reason string) {
+		g.p("%s", errReturn(fmt.Sprintf("NewArgError(%s, %s)", argName, reason)))
+	}
+	switch param.GoType {
+	case "int", "float64":
+		literal := func(f float64) string {
+			if param.GoType == "int" {
+				return strconv.FormatInt(int64(f), 10)
+			}
+			return formatNumber(f)
+		}
+		if schema.Minimum != nil {
+			g.p("if %s < %s {", expr, literal(*schema.Minimum))
+			fail(strconv.Quote("must be at least " + formatNumber(*schema.Minimum)))
+			g.p("}")
+		}
+		if schema.Maximum != nil {
+			g.p("if %s > %s {", expr, literal(*schema.Maximum))
+			fail(strconv.Quote("must be at most " + formatNumber(*schema.Maximum)))
+			g.p("}")
+		}
+		return
+	case "bool", "[]string":
+		return
+	}
+
+	if param.Enum {
+		g.imports["fmt"] = true
+		g.p("if !%s.IsKnown() {", expr)
+		reason := "%q is not one of " + strings.Join(schema.Enum, ", ")
+		if len(schema.Enum) > 10 {
+			reason = "%q is not a known value"
+		}
+		fail(fmt.Sprintf("fmt.Sprintf(%q, %s)", reason, expr))
+		g.p("}")
+		return
+	}
+	if schema.MaxLength != nil {
+		g.p("if len(%s) > %d {", expr, *schema.MaxLength)
+		fail(strconv.Quote(fmt.Sprintf("must not be longer than %d characters", *schema.MaxLength)))
+		g.p("}")
+	}
+	if param.PatternVar != "" {
+		g.imports["regexp"] = true
+		g.imports["fmt"] = true
+		g.p("if !%s.MatchString(%s) {", param.PatternVar, expr)
+		fail(fmt.Sprintf("fmt.Sprintf(%q, %s)", "%q does not match the pattern "+g.patterns[param.PatternVar], expr))
+		g.p("}")
+	}
+}
+
+// setValue writes the statement that sets param to expr in the url.Values named values.
+func (g *generator) setValue(values string, param *parameter, expr string) {
+	if param.GoType == "[]string" {
+		g.p("%s[%q] = %s", values, param.Name, expr)
+		return
+	}
+	g.p("%s.Set(%q, %s)", values, param.Name, g.encode(param, expr))
+}
+
+// encode returns the expression that formats expr of param as API value.
+func (g *generator) encode(param *parameter, expr string) string {
+	switch {
+	case param.Enum:
+		return "string(" + expr + ")"
+	case param.GoType == "int":
+		g.imports["strconv"] = true
+		return "strconv.Itoa(" + expr + ")"
+	case param.GoType == "float64":
+		g.imports["strconv"] = true
+		return "strconv.FormatFloat(" + expr + ", 'f', -1, 64)"
+	case param.GoType == "bool":
+		return "boolToString(" + expr + ")"
+	}
+	return expr
+}
+
+// returnInfo describes how the data of a response is returned.
+type returnInfo struct {
+	GoType   string // result type of the method, empty if nothing is returned
+	RootType string // type of the data field of the response
+	Result   string // expression returning root.Data
+	Zero     string
+	Doc      string
+}
+
+func (g *generator) returnType(method, path string, returns *typeSchema) returnInfo {
+	if returns == nil {
+		return returnInfo{}
+	}
+	info := returnInfo{Doc: returns.Description, Result: "root.Data"}
+	switch returns.Type {
+	case "", "null":
+		return returnInfo{}
+	case "string":
+		info.GoType, info.RootType, info.Zero = "string", "string", `""`
+		if info.Doc == "" {
+			info.Doc = "The UPID of the started task, if the endpoint runs one."
+		}
+	case "integer":
+		// integers are sometimes sent as strings
+		info.GoType, info.RootType, info.Zero = "int", "interface{}", "0"
+		info.Result = "interfaceToInt(root.Data)"
+	case "number":
+		info.GoType, info.RootType, info.Zero = "float64", "float64", "0"
+	case "boolean":
+		info.GoType, info.RootType, info.Zero = "bool", "IntBool", "false"
+		info.Result = "bool(root.Data)"
+	case "object":
+		if len(returns.Properties) == 0 {
+			info.GoType, info.RootType, info.Zero = "map[string]interface{}", "map[string]interface{}", "nil"
+			break
+		}
+		typeName := method + "Response"
+		g.responseStruct(typeName, fmt.Sprintf("the data returned by %s", method), path, returns.Properties)
+		info.GoType, info.RootType, info.Zero = "*"+typeName, "*"+typeName, "nil"
+	case "array":
+		items := returns.Items
+		if items != nil && items.Type == "object" && len(items.Properties) > 0 {
+			typeName := method + "Item"
+			g.responseStruct(typeName, fmt.Sprintf("an element of the list returned by %s", method), path, items.Properties)
+			info.GoType, info.RootType, info.Zero = "[]"+typeName, "[]"+typeName, "nil"
+			break
+		}
+		itemType := "interface{}"
+		if items != nil {
+			itemType = responseGoType(items)
+		}
+		info.GoType, info.RootType, info.Zero = "[]"+itemType, "[]"+itemType, "nil"
+	default:
+		info.GoType, info.RootType, info.Zero = "interface{}", "interface{}", "nil"
+	}
+	return info
+}
+
+func (g *generator) responseStruct(typeName, doc, path string, properties map[string]*typeSchema) {
+	g.declare(typeName, path)
+	g.p("// %s is %s.", typeName, doc)
+	g.p("type %s struct {", typeName)
+	var indexed []string
+	for i, name := range sortedKeys(properties) {
+		schema := properties[name]
+		if i > 0 {
+			g.p("")
+		}
+		if schema.Description != "" {
+			g.comment("", schema.Description)
+		}
+		if len(schema.Enum) > 0 {
+			g.p("// One of: %s.", strings.Join(schema.Enum, ", "))
+		}
+		if strings.HasSuffix(name, "[n]") {
+			base := strings.TrimSuffix(name, "[n]")
+			g.p("// Returned as %s0, %s1 and so on, the keys of the map.", base, base)
+			g.p("%s map[int]%s `json:\"-\"`", goName(name), responseGoType(schema))
+			indexed = append(indexed, fmt.Sprintf("%q: &r.%s", base, goName(name)))
+			continue
+		}
+		tag := name
+		if schema.Optional {
+			tag += ",omitempty"
+		}
+		g.p("%s %s `json:%q`", goName(name), responseGoType(schema), tag)
+	}
+	g.p("}")
+	g.p("")
+
+	// Perl sends numbers as strings now and then
+	g.p("// UnmarshalJSON implements the json.Unmarshaler interface. Numbers sent as strings are accepted.")
+	g.p("func (r *%s) UnmarshalJSON(data []byte) error {", typeName)
+	g.p("type response %s", typeName)
+	if len(indexed) == 0 {
+		g.p("return unmarshalLenientNumbers(data, (*response)(r))")
+	} else {
+		g.p("if err := unmarshalLenientNumbers(data, (*response)(r)); err != nil {")
+		g.p("return err")
+		g.p("}")
+		g.p("return unmarshalIndexed(data, map[string]interface{}{%s})", strings.Join(indexed, ", "))
+	}
+	g.p("}")
+	g.p("")
+}
+
+func responseGoType(schema *typeSchema) string {
+	switch schema.Type {
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "IntBool"
+	case "string":
+		return "string"
+	case "array":
+		if schema.Items != nil && schema.Items.Type != "object" && schema.Items.Type != "array" {
+			return "[]" + responseGoType(schema.Items)
+		}
+		return "[]interface{}"
+	case "object":
+		return "map[string]interface{}"
+	}
+	return "interface{}"
+}
+
+func scalarGoType(schemaType string) string {
+	switch schemaType {
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return "string"
+}
+
+// comment writes text as a comment wrapped at 110 columns.
+func (g *generator) comment(indent, text string) {
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+len(word)+1 > 110 {
+			g.p("%s// %s", indent, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		g.p("%s// %s", indent, line)
+	}
+}
+
+// compilePattern converts a Perl pattern of the schema. Patterns Go can't handle are not checked.
+func compilePattern(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	if !strings.HasPrefix(pattern, "^") {
+		pattern = "^(?:" + pattern + ")$"
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+func backquote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func sortedKeys(m map[string]*typeSchema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// methodName builds the method name from the HTTP method and the path segments, e.g.
+// PostNodesQemuStatusStart for POST /nodes/{node}/qemu/{vmid}/status/start. A parameter at the end of the
+// path is part of the name to tell the item from the collection: GetNodesQemuVMID.
+func methodName(httpMethod, path string) string {
+	name := strings.Title(strings.ToLower(httpMethod))
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && i < len(segments)-1 {
+			continue
+		}
+		name += goName(strings.Trim(segment, "{}"))
+	}
+	return name
+}
+
+// Words that are written in upper case in Go names.
+var initialisms = map[string]string{
+	"acl": "ACL", "acme": "ACME", "api": "API", "ca": "CA", "ceph": "Ceph", "cpu": "CPU", "cpus": "CPUs",
+	"dns": "DNS", "ha": "HA", "http": "HTTP", "id": "ID", "ip": "IP", "lxc": "LXC", "mac": "MAC",
+	"mtu": "MTU", "os": "OS", "pid": "PID", "qmp": "QMP", "rrd": "RRD", "sdn": "SDN", "sid": "SID",
+	"ssh": "SSH", "ssl": "SSL", "tfa": "TFA", "upid": "UPID", "uri": "URI", "url": "URL", "vm": "VM",
+	"vmid": "VMID", "vmstate": "VMState", "vnc": "VNC",
+}
+
+// Compound words of the API that read better split.
+var compounds = map[string]string{
+	"agentcmd": "AgentCmd", "bwlimit": "BWLimit", "cf": "CF", "diskread": "DiskRead",
+	"diskwrite": "DiskWrite", "exitcode": "ExitCode", "exitstatus": "ExitStatus", "hastate": "HAState",
+	"maxcpu": "MaxCPU", "maxdisk": "MaxDisk", "maxmem": "MaxMem", "maxworkers": "MaxWorkers",
+	"migrateall": "MigrateAll", "migratedfrom": "MigratedFrom", "netin": "NetIn", "netout": "NetOut",
+	"newid": "NewID", "nextid": "NextID", "nocheck": "NoCheck", "plugintype": "PluginType",
+	"pstart": "PStart", "qmpstatus": "QMPStatus", "rrddata": "RRDData", "sendkey": "SendKey",
+	"skiplock": "SkipLock", "snapname": "SnapName", "spiceproxy": "SpiceProxy", "sshkeys": "SSHKeys",
+	"startall": "StartAll", "starttime": "StartTime", "statestorage": "StateStorage", "stateuri": "StateURI",
+	"stopall": "StopAll", "targetstorage": "TargetStorage", "termproxy": "TermProxy", "todisk": "ToDisk",
+	"vmdiridx": "VMDirIdx", "vncproxy": "VNCProxy", "vncticket": "VNCTicket", "vncwebsocket": "VNCWebSocket",
+}
+
+// words splits an API name at non-alphanumeric characters and camel case boundaries.
+func words(name string) []string {
+	var result []string
+	current := []rune{}
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				result = append(result, string(current))
+			}
+			current = current[:0]
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 && unicode.IsLower(runes[i-1]) && len(current) > 0 {
+			result = append(result, string(current))
+			current = current[:0]
+		}
+		current = append(current, r)
+	}
+	if len(current) > 0 {
+		result = append(result, string(current))
+	}
+	return result
+}
+
+func wordName(word string) string {
+	lower := strings.ToLower(word)
+	if s, ok := initialisms[lower]; ok {
+		return s
+	}
+	if s, ok := compounds[lower]; ok {
+		return s
+	}
+	return strings.Title(lower)
+}
+
+// goName returns the exported Go name of an API name.
+func goName(name string) string {
+	var result string
+	for _, word := range words(name) {
+		result += wordName(word)
+	}
+	if result == "" || unicode.IsDigit([]rune(result)[0]) {
+		result = "V" + result
+	}
+	return result
+}
+
+// lowerCamel returns the unexported Go name of an API name.
+func lowerCamel(name string) string {
+	parts := words(name)
+	if len(parts) == 0 {
+		return "v"
+	}
+	result := lowerFirst(wordName(parts[0]))
+	if _, ok := initialisms[strings.ToLower(parts[0])]; ok {
+		result = strings.ToLower(parts[0])
+	}
+	for _, word := range parts[1:] {
+		result += wordName(word)
+	}
+	return result
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	// lower a leading initialism as a whole: VNCTicket becomes vncTicket
+	runes := []rune(s)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
@@ -0,0 +1,326 @@
+package goproxmox
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/andrexus/goproxmox/pveauth"
+	"golang.org/x/net/websocket"
+)
+
+// Interval of the keepalive messages TermConn sends. The terminal proxy closes idle connections.
+const termKeepaliveInterval = 30 * time.Second
+
+// VNCProxyConfig holds the optional parameters of VNCProxy.
+type VNCProxyConfig struct {
+	// Prepare for a websocket upgrade, see OpenVNCWebSocket
+	Websocket *bool
+
+	// Generate a random password to be used as VNC password instead of the ticket
+	GeneratePassword *bool
+}
+
+func (c *VNCProxyConfig) apiParams() *PostNodesQemuVNCProxyParams {
+	if c == nil {
+		return nil
+	}
+	return &PostNodesQemuVNCProxyParams{
+		Websocket:        c.Websocket,
+		GeneratePassword: c.GeneratePassword,
+	}
+}
+
+// VNCProxyTicket is a VNC proxy started by VNCProxy. The proxy accepts a single connection and stops after
+// about 10 seconds if nobody connects.
+type VNCProxyTicket struct {
+	Port   int
+	Ticket string // Also the VNC password unless a password was generated
+	Cert   string
+	User   string
+	UPID   string
+
+	// Only set with VNCProxyConfig.GeneratePassword
+	Password string
+}
+
+// TermProxyConfig holds the optional parameters of TermProxy.
+type TermProxyConfig struct {
+	// Number of the serial device (see VMConfig.SerialDevices) to attach to. Defaults to the display.
+	Serial *int
+}
+
+func (c *TermProxyConfig) apiParams() (*PostNodesQemuTermProxyParams, error) {
+	if c == nil || c.Serial == nil {
+		return nil, nil
+	}
+	serial := PostNodesQemuTermProxySerial(fmt.Sprintf("serial%d", *c.Serial))
+	if !serial.IsKnown() {
+		return nil, NewArgError("serial", fmt.Sprintf("%d is not within 0-3", *c.Serial))
+	}
+	return &PostNodesQemuTermProxyParams{Serial: &serial}, nil
+}
+
+// TermProxyTicket is a terminal proxy started by TermProxy.
+type TermProxyTicket struct {
+	Port   int
+	Ticket string
+	User   string
+	UPID   string
+}
+
+// SpiceProxyTicket holds the connection settings returned by SpiceProxy, e.g. "host", "tls-port",
+// "password" and "proxy". They can be passed to remote-viewer as they are.
+type SpiceProxyTicket map[string]string
+
+// Start a VNC proxy for the VM.
+func (s *QemuServiceOp) VNCProxy(ctx context.Context, node string, vmID int, config *VNCProxyConfig) (*VNCProxyTicket, error) {
+	response, err := s.client.API.PostNodesQemuVNCProxy(ctx, node, vmID, config.apiParams())
+	if err != nil {
+		return nil, err
+	}
+	return &VNCProxyTicket{
+		Port:     int(response.Port),
+		Ticket:   response.Ticket,
+		Cert:     response.Cert,
+		User:     response.User,
+		UPID:     response.UPID,
+		Password: response.Password,
+	}, nil
+}
+
+// Start a terminal proxy for the display or a serial device of the VM. Connect to it with OpenTermWebSocket.
+func (s *QemuServiceOp) TermProxy(ctx context.Context, node string, vmID int, config *TermProxyConfig) (*TermProxyTicket, error) {
+	params, err := config.apiParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.client.API.PostNodesQemuTermProxy(ctx, node, vmID, params)
+	if err != nil {
+		return nil, err
+	}
+	return &TermProxyTicket{
+		Port:   int(response.Port),
+		Ticket: response.Ticket,
+		User:   response.User,
+		UPID:   response.UPID,
+	}, nil
+}
+
+// Get a SPICE ticket for the VM. proxy is the SPICE proxy the client should connect through, by default the
+// node the VM is running on.
+func (s *QemuServiceOp) SpiceProxy(ctx context.Context, node string, vmID int, proxy string) (SpiceProxyTicket, error) {
+	var params *PostNodesQemuSpiceProxyParams
+	if proxy != "" {
+		params = &PostNodesQemuSpiceProxyParams{Proxy: String(proxy)}
+	}
+	response, err := s.client.API.PostNodesQemuSpiceProxy(ctx, node, vmID, params)
+	if err != nil {
+		return nil, err
+	}
+	ticket := make(SpiceProxyTicket)
+	for k, v := range response {
+		ticket[k] = interfaceToString(v)
+	}
+	return ticket, nil
+}
+
+// Connect to the VNC proxy of ticket through the vncwebsocket endpoint. The returned stream carries the raw
+// RFB protocol; the VNC password is the ticket (or the generated password).
+func (s *QemuServiceOp) OpenVNCWebSocket(ctx context.Context, node string, vmID int, ticket *VNCProxyTicket) (io.ReadWriteCloser, error) {
+	if ticket == nil {
+		return nil, NewArgError("ticket", "cannot be nil")
+	}
+	return s.client.dialVNCWebSocket(ctx, node, vmID, ticket.Port, ticket.Ticket)
+}
+
+// Connect to the terminal proxy of ticket through the vncwebsocket endpoint and log in.
+func (s *QemuServiceOp) OpenTermWebSocket(ctx context.Context, node string, vmID int, ticket *TermProxyTicket) (*TermConn, error) {
+	if ticket == nil {
+		return nil, NewArgError("ticket", "cannot be nil")
+	}
+	ws, err := s.client.dialVNCWebSocket(ctx, node, vmID, ticket.Port, ticket.Ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	// the terminal proxy expects "user:ticket\n" and answers with OK
+	if _, err := ws.Write([]byte(ticket.User + ":" + ticket.Ticket + "\n")); err != nil {
+		ws.Close()
+		return nil, err
+	}
+	var answer []byte
+	if err := websocket.Message.Receive(ws, &answer); err != nil {
+		ws.Close()
+		return nil, err
+	}
+	if string(answer) != "OK" {
+		ws.Close()
+		return nil, fmt.Errorf("terminal proxy login failed: %q", answer)
+	}
+	return newTermConn(ws), nil
+}
+
+// dialVNCWebSocket opens a websocket to the vncwebsocket endpoint of the VM, authenticated with the ticket
+// of the client. ctx only limits the connection setup.
+func (c *Client) dialVNCWebSocket(ctx context.Context, node string, vmID int, port int, vncTicket string) (*websocket.Conn, error) {
+	auth, err := c.Ticket()
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("port", strconv.Itoa(port))
+	query.Set("vncticket", vncTicket)
+	rel := &url.URL{
+		Path:     fmt.Sprintf("nodes/%s/qemu/%d/vncwebsocket", node, vmID),
+		RawQuery: query.Encode(),
+	}
+	u := c.BaseURL.ResolveReference(rel)
+	origin := &url.URL{Scheme: u.Scheme, Host: u.Host}
+	location := *u
+	switch u.Scheme {
+	case "https":
+		location.Scheme = "wss"
+	case "http":
+		location.Scheme = "ws"
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	config, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	config.Protocol = []string{"binary"}
+	config.Header.Set("Cookie", "PVEAuthCookie="+auth.Ticket)
+
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), map[string]string{"http": "80", "https": "443"}[u.Scheme])
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "https" {
+		tlsConfig := c.tlsConfig()
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = u.Hostname()
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if deadline, ok := ctx.Deadline(); ok {
+			tlsConn.SetDeadline(deadline)
+		}
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		tlsConn.SetDeadline(time.Time{})
+		conn = tlsConn
+	}
+
+	ws, err := websocket.NewClient(config, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	ws.PayloadType = websocket.BinaryFrame
+	return ws, nil
+}
+
+// tlsConfig returns a copy of the TLS config of the HTTP transport of the client, so that websockets trust
+// the same certificates as API requests.
+func (c *Client) tlsConfig() *tls.Config {
+	transport := c.client.Transport
+	if t, ok := transport.(*pveauth.Transport); ok {
+		transport = t.Base
+	}
+	if t, ok := transport.(*http.Transport); ok && t.TLSClientConfig != nil {
+		return t.TLSClientConfig.Clone()
+	}
+	return &tls.Config{}
+}
+
+// TermConn is a logged in connection to a terminal proxy. Reads return the terminal output, writes send
+// input. The connection is kept alive until it is closed.
+type TermConn struct {
+	ws *websocket.Conn
+
+	mu   sync.Mutex // serializes writes
+	done chan struct{}
+	once sync.Once
+}
+
+var _ io.ReadWriteCloser = &TermConn{}
+
+func newTermConn(ws *websocket.Conn) *TermConn {
+	conn := &TermConn{ws: ws, done: make(chan struct{})}
+	go conn.keepalive()
+	return conn
+}
+
+func (c *TermConn) keepalive() {
+	ticker := time.NewTicker(termKeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.send("2"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *TermConn) send(message string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err := c.ws.Write([]byte(message))
+	return err
+}
+
+// Read terminal output.
+func (c *TermConn) Read(p []byte) (int, error) {
+	return c.ws.Read(p)
+}
+
+// Write input to the terminal.
+func (c *TermConn) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := c.send(fmt.Sprintf("0:%d:%s", len(p), p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Resize the terminal.
+func (c *TermConn) Resize(columns, rows int) error {
+	if columns <= 0 || rows <= 0 {
+		return NewArgError("size", fmt.Sprintf("%dx%d is not positive", columns, rows))
+	}
+	return c.send(fmt.Sprintf("1:%d:%d:", columns, rows))
+}
+
+// Close the connection. The terminal proxy ends with it.
+func (c *TermConn) Close() error {
+	err := errors.New("connection already closed")
+	c.once.Do(func() {
+		close(c.done)
+		err = c.ws.Close()
+	})
+	return err
+}
@@ -0,0 +1,496 @@
+// Package consoleproxy serves VM consoles to browsers (noVNC or xterm.js) without handing out Proxmox
+// credentials. The Handler authorizes every request with a callback, opens a VNC or terminal proxy on the
+// node with its own Client and bridges the browser websocket to it.
+package consoleproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/andrexus/goproxmox"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	defaultIdleTimeout  = 15 * time.Minute
+	defaultWriteTimeout = 10 * time.Second
+	defaultBufferSize   = 32 * 1024
+)
+
+var (
+	errIdleTimeout = errors.New("idle timeout")
+	errTermLogin   = errors.New("terminal client did not log in")
+)
+
+// ConsoleType selects between the graphical console and a terminal.
+type ConsoleType string
+
+const (
+	// VNC is the graphical console, spoken by noVNC
+	VNC ConsoleType = "vnc"
+
+	// Term is the display or a serial device as text terminal, spoken by the xterm.js client of PVE
+	Term ConsoleType = "term"
+)
+
+// Console identifies the console of a VM.
+type Console struct {
+	// Node of the VM. Optional, the VM is looked up in the cluster if empty
+	Node string
+
+	VMID int
+
+	// Defaults to VNC
+	Type ConsoleType
+
+	// Term only: number of the serial device to attach to. Defaults to the display
+	Serial *int
+}
+
+// Authorization is the console a request may open and who opens it.
+type Authorization struct {
+	Console
+
+	// Recorded in the audit events, e.g. the user name of the portal
+	Subject string
+}
+
+// AuthorizeFunc decides whether a request may open a console and which one. A nil Authorization or an
+// error denies the request with 403 Forbidden.
+type AuthorizeFunc func(r *http.Request) (*Authorization, error)
+
+// EventType is the kind of an audit Event.
+type EventType string
+
+const (
+	EventDenied EventType = "denied" // the request was not authorized
+	EventFailed EventType = "failed" // the console could not be opened
+	EventOpened EventType = "opened"
+	EventClosed EventType = "closed"
+)
+
+// Event is an audit event of a console session.
+type Event struct {
+	Type       EventType
+	SessionID  string
+	Time       time.Time
+	RemoteAddr string
+
+	// Empty for denied requests
+	Subject string
+	Console Console
+
+	// Closed events only
+	Duration time.Duration
+	BytesIn  int64 // From the browser to the VM
+	BytesOut int64 // From the VM to the browser
+
+	// Why the request was denied, the console failed or the session ended. Nil if either side hung up.
+	Err error
+}
+
+// Handler serves console websockets. Its zero value is not usable, Client and Authorize must be set.
+type Handler struct {
+	Client    *goproxmox.Client
+	Authorize AuthorizeFunc
+
+	// Optional check of the Origin header of the websocket request. If nil, the host of the Origin header
+	// must equal the Host of the request
+	CheckOrigin func(r *http.Request) bool
+
+	// The session is closed when no data was passed in either direction for IdleTimeout. Defaults to 15m
+	IdleTimeout time.Duration
+
+	// A browser that does not take the console output for WriteTimeout is disconnected. Reading from the
+	// console stops while the browser is behind. Defaults to 10s
+	WriteTimeout time.Duration
+
+	// Size of the relay buffers. Defaults to 32KiB
+	BufferSize int
+
+	// Optional audit sink, called synchronously for every session event
+	Audit func(Event)
+}
+
+var _ http.Handler = &Handler{}
+
+// session is a single console connection.
+type session struct {
+	// accessed atomically, first for 64-bit alignment
+	bytesIn    int64
+	bytesOut   int64
+	lastActive int64 // unix nanoseconds
+
+	id          string
+	remoteAddr  string
+	started     time.Time
+	auth        Authorization
+	closeOnce   sync.Once
+	closeReason error
+	closers     []io.Closer
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s := &session{id: newSessionID(), remoteAddr: r.RemoteAddr, started: time.Now()}
+
+	auth, err := h.Authorize(r)
+	if err == nil && auth == nil {
+		err = errors.New("not authorized")
+	}
+	if err == nil {
+		err = h.checkOrigin(r)
+	}
+	if err != nil {
+		h.audit(s, EventDenied, err)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	s.auth = *auth
+	if s.auth.Type == "" {
+		s.auth.Type = VNC
+	}
+
+	upstream, password, err := h.connect(r.Context(), &s.auth.Console)
+	if err != nil {
+		h.audit(s, EventFailed, err)
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	served := false
+	server := websocket.Server{
+		Handshake: func(config *websocket.Config, r *http.Request) error {
+			if err := h.checkOrigin(r); err != nil {
+				return err
+			}
+			return selectProtocol(config, r)
+		},
+		Handler: func(ws *websocket.Conn) {
+			served = true
+			h.serve(s, ws, upstream, password)
+		},
+	}
+	server.ServeHTTP(w, r)
+	if !served {
+		upstream.Close()
+		h.audit(s, EventFailed, errors.New("websocket handshake failed"))
+	}
+}
+
+// checkOrigin checks the Origin header with CheckOrigin, or requires the same origin if CheckOrigin is nil.
+func (h *Handler) checkOrigin(r *http.Request) error {
+	if h.CheckOrigin != nil {
+		if !h.CheckOrigin(r) {
+			return errors.New("origin not allowed")
+		}
+		return nil
+	}
+	origin, err := url.Parse(r.Header.Get("Origin"))
+	if err != nil || origin.Host == "" {
+		return errors.New("missing or invalid origin")
+	}
+	if !strings.EqualFold(origin.Host, r.Host) {
+		return fmt.Errorf("origin %s not allowed for host %s", origin.Host, r.Host)
+	}
+	return nil
+}
+
+// connect opens the console on the node. For VNC it also returns the VNC password.
+func (h *Handler) connect(ctx context.Context, console *Console) (io.ReadWriteCloser, string, error) {
+	if console.Node == "" {
+		location, err := h.Client.Cluster.FindVM(ctx, console.VMID)
+		if err != nil {
+			return nil, "", err
+		}
+		console.Node = location.Node
+	}
+
+	switch console.Type {
+	case VNC:
+		ticket, err := h.Client.VMs.VNCProxy(ctx, console.Node, console.VMID, &goproxmox.VNCProxyConfig{Websocket: goproxmox.Bool(true)})
+		if err != nil {
+			return nil, "", err
+		}
+		conn, err := h.Client.VMs.OpenVNCWebSocket(ctx, console.Node, console.VMID, ticket)
+		if err != nil {
+			return nil, "", err
+		}
+		return conn, ticket.Ticket, nil
+	case Term:
+		ticket, err := h.Client.VMs.TermProxy(ctx, console.Node, console.VMID, &goproxmox.TermProxyConfig{Serial: console.Serial})
+		if err != nil {
+			return nil, "", err
+		}
+		conn, err := h.Client.VMs.OpenTermWebSocket(ctx, console.Node, console.VMID, ticket)
+		if err != nil {
+			return nil, "", err
+		}
+		return conn, "", nil
+	}
+	return nil, "", goproxmox.NewArgError("type", strconv.Quote(string(console.Type))+" is not a console type")
+}
+
+// selectProtocol accepts the "binary" subprotocol offered by noVNC, the server must not answer with more
+// than one protocol.
+func selectProtocol(config *websocket.Config, r *http.Request) error {
+	for _, protocol := range config.Protocol {
+		if protocol == "binary" {
+			config.Protocol = []string{protocol}
+			return nil
+		}
+	}
+	if len(config.Protocol) > 0 {
+		config.Protocol = config.Protocol[:1]
+	}
+	return nil
+}
+
+func (h *Handler) serve(s *session, ws *websocket.Conn, upstream io.ReadWriteCloser, password string) {
+	ws.PayloadType = websocket.BinaryFrame
+	s.closers = []io.Closer{ws, upstream}
+	s.touch()
+	browser := &deadlineWriter{ws: ws, timeout: h.writeTimeout()}
+
+	var err error
+	switch conn := upstream.(type) {
+	case *goproxmox.TermConn:
+		err = termLogin(ws)
+	default:
+		err = vncAuthenticate(readWriter{ws, browser}, conn, password)
+	}
+	if err != nil {
+		s.close(err)
+		h.audit(s, EventFailed, err)
+		return
+	}
+	h.audit(s, EventOpened, nil)
+
+	done := make(chan struct{})
+	go h.watchIdle(s, done)
+
+	finished := make(chan struct{}, 2)
+	go func() {
+		s.close(h.copy(browser, upstream, &s.bytesOut, s))
+		finished <- struct{}{}
+	}()
+	go func() {
+		if conn, ok := upstream.(*goproxmox.TermConn); ok {
+			s.close(h.copyTermInput(conn, ws, s))
+		} else {
+			s.close(h.copy(upstream, ws, &s.bytesIn, s))
+		}
+		finished <- struct{}{}
+	}()
+	<-finished
+	<-finished
+	close(done)
+	h.audit(s, EventClosed, s.closeReason)
+}
+
+// copy relays src to dst. A write blocks until dst took the data, so a slow reader throttles the writer.
+func (h *Handler) copy(dst io.Writer, src io.Reader, counter *int64, s *session) error {
+	buf := make([]byte, h.bufferSize())
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return err
+			}
+			atomic.AddInt64(counter, int64(n))
+			s.touch()
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// copyTermInput passes the messages of the xterm.js client to TermConn calls. The connection keeps itself
+// alive, so keepalives of the client are dropped.
+func (h *Handler) copyTermInput(conn *goproxmox.TermConn, ws *websocket.Conn, s *session) error {
+	for {
+		var message []byte
+		if err := websocket.Message.Receive(ws, &message); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		parsed, ok := parseTermMessage(message)
+		if !ok {
+			continue
+		}
+		if parsed.resize {
+			if err := conn.Resize(parsed.columns, parsed.rows); err != nil {
+				continue
+			}
+		} else {
+			if _, err := conn.Write(parsed.input); err != nil {
+				return err
+			}
+			atomic.AddInt64(&s.bytesIn, int64(len(parsed.input)))
+		}
+		s.touch()
+	}
+}
+
+// termMessage is an input or resize message of the xterm.js client.
+type termMessage struct {
+	input         []byte
+	resize        bool
+	columns, rows int
+}
+
+// parseTermMessage parses "0:length:data" for input and "1:columns:rows:" for a resize. The length is the
+// number of bytes of data. Keepalives ("2") and malformed messages are not ok.
+func parseTermMessage(message []byte) (termMessage, bool) {
+	if len(message) < 2 || message[1] != ':' {
+		return termMessage{}, false
+	}
+	fields := bytes.SplitN(message[2:], []byte(":"), 3)
+	if len(fields) < 2 {
+		return termMessage{}, false
+	}
+	switch message[0] {
+	case '0':
+		input := bytes.Join(fields[1:], []byte(":"))
+		if length, err := strconv.Atoi(string(fields[0])); err != nil || length != len(input) {
+			return termMessage{}, false
+		}
+		return termMessage{input: input}, true
+	case '1':
+		columns, err := strconv.Atoi(string(fields[0]))
+		if err != nil {
+			return termMessage{}, false
+		}
+		rows, err := strconv.Atoi(string(fields[1]))
+		if err != nil {
+			return termMessage{}, false
+		}
+		return termMessage{resize: true, columns: columns, rows: rows}, true
+	}
+	return termMessage{}, false
+}
+
+// termLogin answers the login message of the xterm.js client. The handler logged in to the terminal proxy
+// already, so the message only carries whatever the browser made of a ticket it never got.
+func termLogin(ws *websocket.Conn) error {
+	var login []byte
+	if err := websocket.Message.Receive(ws, &login); err != nil {
+		return err
+	}
+	if !bytes.HasSuffix(login, []byte("\n")) {
+		return errTermLogin
+	}
+	return websocket.Message.Send(ws, []byte("OK"))
+}
+
+func (h *Handler) watchIdle(s *session, done chan struct{}) {
+	timeout := h.idleTimeout()
+	ticker := time.NewTicker(timeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, atomic.LoadInt64(&s.lastActive))) >= timeout {
+				s.close(errIdleTimeout)
+				return
+			}
+		}
+	}
+}
+
+func (s *session) touch() {
+	atomic.StoreInt64(&s.lastActive, time.Now().UnixNano())
+}
+
+// close ends both sides of the session. The first reason wins.
+func (s *session) close(reason error) {
+	s.closeOnce.Do(func() {
+		s.closeReason = reason
+		for _, closer := range s.closers {
+			closer.Close()
+		}
+	})
+}
+
+func (h *Handler) audit(s *session, eventType EventType, err error) {
+	if h.Audit == nil {
+		return
+	}
+	event := Event{
+		Type:       eventType,
+		SessionID:  s.id,
+		Time:       time.Now(),
+		RemoteAddr: s.remoteAddr,
+		Subject:    s.auth.Subject,
+		Console:    s.auth.Console,
+		Err:        err,
+	}
+	if eventType == EventClosed {
+		event.Duration = event.Time.Sub(s.started)
+		event.BytesIn = atomic.LoadInt64(&s.bytesIn)
+		event.BytesOut = atomic.LoadInt64(&s.bytesOut)
+	}
+	h.Audit(event)
+}
+
+func (h *Handler) idleTimeout() time.Duration {
+	if h.IdleTimeout > 0 {
+		return h.IdleTimeout
+	}
+	return defaultIdleTimeout
+}
+
+func (h *Handler) writeTimeout() time.Duration {
+	if h.WriteTimeout > 0 {
+		return h.WriteTimeout
+	}
+	return defaultWriteTimeout
+}
+
+func (h *Handler) bufferSize() int {
+	if h.BufferSize > 0 {
+		return h.BufferSize
+	}
+	return defaultBufferSize
+}
+
+// deadlineWriter fails a write to the browser that does not complete within timeout.
+type deadlineWriter struct {
+	ws      *websocket.Conn
+	timeout time.Duration
+}
+
+func (w *deadlineWriter) Write(p []byte) (int, error) {
+	w.ws.SetWriteDeadline(time.Now().Add(w.timeout))
+	return w.ws.Write(p)
+}
+
+type readWriter struct {
+	io.Reader
+	io.Writer
+}
+
+func newSessionID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,80 @@
+package consoleproxy
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+func TestParseTermMessage(t *testing.T) {
+	tests := []struct {
+		message string
+		ok      bool
+		want    termMessage
+	}{
+		{message: "0:1:a", ok: true, want: termMessage{input: []byte("a")}},
+		{message: "0:5:ls -l", ok: true, want: termMessage{input: []byte("ls -l")}},
+		{message: "0:3:a:b", ok: true, want: termMessage{input: []byte("a:b")}},
+		{message: "0:2:\r\n", ok: true, want: termMessage{input: []byte("\r\n")}},
+		{message: "0:0:", ok: true, want: termMessage{input: []byte{}}},
+		{message: "0:2:\u00e9", ok: true, want: termMessage{input: []byte("\u00e9")}},
+		{message: "1:80:24:", ok: true, want: termMessage{resize: true, columns: 80, rows: 24}},
+		{message: "1:132:50:", ok: true, want: termMessage{resize: true, columns: 132, rows: 50}},
+		{message: "2"},
+		{message: "0:5"},
+		{message: "0:5:a"},
+		{message: "0:1:ls"},
+		{message: "0:x:a"},
+		{message: "1:x:24:"},
+		{message: "1:80"},
+		{message: "3:1:a"},
+		{message: "0"},
+		{message: "x1:a"},
+		{message: ""},
+	}
+
+	for _, test := range tests {
+		got, ok := parseTermMessage([]byte(test.message))
+		if ok != test.ok {
+			t.Errorf("%q: ok = %v, want %v", test.message, ok, test.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !bytes.Equal(got.input, test.want.input) || got.resize != test.want.resize ||
+			got.columns != test.want.columns || got.rows != test.want.rows {
+			t.Errorf("%q: parsed as %+v, want %+v", test.message, got, test.want)
+		}
+	}
+}
+
+func TestCheckOrigin(t *testing.T) {
+	allowExample := func(r *http.Request) bool { return r.Header.Get("Origin") == "https://example.com" }
+	tests := []struct {
+		origin      string
+		host        string
+		checkOrigin func(r *http.Request) bool
+		ok          bool
+	}{
+		{origin: "https://pve.example.com", host: "pve.example.com", ok: true},
+		{origin: "https://PVE.example.com:8006", host: "pve.example.com:8006", ok: true},
+		{origin: "https://evil.example.com", host: "pve.example.com"},
+		{origin: "https://pve.example.com:8443", host: "pve.example.com:8006"},
+		{origin: "null", host: "pve.example.com"},
+		{origin: "", host: "pve.example.com"},
+		{origin: "https://example.com", host: "pve.example.com", checkOrigin: allowExample, ok: true},
+		{origin: "https://pve.example.com", host: "pve.example.com", checkOrigin: allowExample},
+	}
+
+	for _, test := range tests {
+		h := &Handler{CheckOrigin: test.checkOrigin}
+		r := &http.Request{Host: test.host, Header: http.Header{}}
+		if test.origin != "" {
+			r.Header.Set("Origin", test.origin)
+		}
+		if err := h.checkOrigin(r); (err == nil) != test.ok {
+			t.Errorf("origin %q, host %q: err = %v, want ok %v", test.origin, test.host, err, test.ok)
+		}
+	}
+}
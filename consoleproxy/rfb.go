@@ -0,0 +1,96 @@
+package consoleproxy
+
+import (
+	"bytes"
+	"crypto/des"
+	"fmt"
+	"io"
+)
+
+const (
+	rfbVersion      = "RFB 003.008\n"
+	rfbSecurityNone = 1
+	rfbSecurityVNC  = 2
+)
+
+// vncAuthenticate runs the RFB 3.8 handshake between browser and VNC server. The server is authenticated
+// with password, the browser is offered no authentication and so never sees the password. The security
+// result of the server is left in the stream for the browser.
+func vncAuthenticate(browser, server io.ReadWriter, password string) error {
+	version := make([]byte, len(rfbVersion))
+	if _, err := io.ReadFull(server, version); err != nil {
+		return err
+	}
+	if _, err := browser.Write(version); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(browser, version); err != nil {
+		return err
+	}
+	if string(version) != rfbVersion {
+		return fmt.Errorf("unsupported RFB version %q", version)
+	}
+	if _, err := server.Write(version); err != nil {
+		return err
+	}
+
+	count := make([]byte, 1)
+	if _, err := io.ReadFull(server, count); err != nil {
+		return err
+	}
+	if count[0] == 0 {
+		return fmt.Errorf("VNC server refused the connection")
+	}
+	types := make([]byte, count[0])
+	if _, err := io.ReadFull(server, types); err != nil {
+		return err
+	}
+	if bytes.IndexByte(types, rfbSecurityVNC) < 0 {
+		return fmt.Errorf("VNC server does not offer VNC authentication")
+	}
+
+	if _, err := browser.Write([]byte{1, rfbSecurityNone}); err != nil {
+		return err
+	}
+	selected := make([]byte, 1)
+	if _, err := io.ReadFull(browser, selected); err != nil {
+		return err
+	}
+	if selected[0] != rfbSecurityNone {
+		return fmt.Errorf("browser selected unknown security type %d", selected[0])
+	}
+
+	if _, err := server.Write([]byte{rfbSecurityVNC}); err != nil {
+		return err
+	}
+	challenge := make([]byte, 16)
+	if _, err := io.ReadFull(server, challenge); err != nil {
+		return err
+	}
+	_, err := server.Write(vncAuthResponse(password, challenge))
+	return err
+}
+
+// vncAuthResponse encrypts the challenge with DES, keyed with the first 8 bytes of the password with the
+// bits of every byte reversed.
+func vncAuthResponse(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i, b := range key {
+		var reversed byte
+		for bit := uint(0); bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				reversed |= 0x80 >> bit
+			}
+		}
+		key[i] = reversed
+	}
+
+	// the key always has the right size
+	cipher, _ := des.NewCipher(key)
+	response := make([]byte, len(challenge))
+	for i := 0; i+des.BlockSize <= len(challenge); i += des.BlockSize {
+		cipher.Encrypt(response[i:i+des.BlockSize], challenge[i:i+des.BlockSize])
+	}
+	return response
+}
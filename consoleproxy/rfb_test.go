@@ -0,0 +1,118 @@
+package consoleproxy
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestVNCAuthResponse(t *testing.T) {
+	challenge := []byte("0123456789abcdef")
+	tests := []struct {
+		password  string
+		challenge []byte
+		response  string
+	}{
+		{password: "secretpw", challenge: challenge, response: "2a9418c5eead41778dcdd76ea4128646"},
+		{password: "pw", challenge: challenge, response: "845f7bd7d2190ec213938238de53373b"},
+		// only the first 8 bytes of the password are used
+		{password: "longpassword", challenge: challenge, response: "7b75c4a55e86586a3db02ea6bc6f550c"},
+		{password: "longpass", challenge: challenge, response: "7b75c4a55e86586a3db02ea6bc6f550c"},
+		// DES test vector of the zero key
+		{password: "", challenge: make([]byte, 16), response: "8ca64de9c1b123a78ca64de9c1b123a7"},
+	}
+
+	for _, test := range tests {
+		response := hex.EncodeToString(vncAuthResponse(test.password, test.challenge))
+		if response != test.response {
+			t.Errorf("%q: response = %s, want %s", test.password, response, test.response)
+		}
+	}
+}
+
+func TestVNCAuthenticate(t *testing.T) {
+	const password = "secretpw"
+	challenge := []byte("0123456789abcdef")
+	tests := []struct {
+		name           string
+		securityTypes  []byte
+		browserVersion string
+		browserType    byte
+		err            string // part of the error, empty if the handshake succeeds
+	}{
+		{name: "vnc auth", securityTypes: []byte{rfbSecurityVNC}, browserVersion: rfbVersion, browserType: rfbSecurityNone},
+		{name: "several types", securityTypes: []byte{rfbSecurityNone, rfbSecurityVNC, 19}, browserVersion: rfbVersion, browserType: rfbSecurityNone},
+		{name: "refused", securityTypes: []byte{}, browserVersion: rfbVersion, err: "refused"},
+		{name: "no vnc auth", securityTypes: []byte{rfbSecurityNone}, browserVersion: rfbVersion, err: "does not offer VNC authentication"},
+		{name: "old browser", securityTypes: []byte{rfbSecurityVNC}, browserVersion: "RFB 003.003\n", err: "unsupported RFB version"},
+		{name: "browser selects vnc auth", securityTypes: []byte{rfbSecurityVNC}, browserVersion: rfbVersion, browserType: rfbSecurityVNC, err: "unknown security type 2"},
+	}
+
+	for _, test := range tests {
+		test := test // used by the fake peers
+		browser, browserPeer := net.Pipe()
+		server, serverPeer := net.Pipe()
+
+		// fake VNC server, sends the response to the challenge
+		responses := make(chan []byte, 1)
+		go func() {
+			defer close(responses)
+			version := make([]byte, len(rfbVersion))
+			serverPeer.Write([]byte(rfbVersion))
+			if _, err := io.ReadFull(serverPeer, version); err != nil {
+				return
+			}
+			serverPeer.Write(append([]byte{byte(len(test.securityTypes))}, test.securityTypes...))
+			selected := make([]byte, 1)
+			if _, err := io.ReadFull(serverPeer, selected); err != nil || selected[0] != rfbSecurityVNC {
+				return
+			}
+			serverPeer.Write(challenge)
+			response := make([]byte, len(challenge))
+			if _, err := io.ReadFull(serverPeer, response); err == nil {
+				responses <- response
+			}
+		}()
+
+		// fake browser, sends the security types it was offered
+		offers := make(chan []byte, 1)
+		go func() {
+			defer close(offers)
+			version := make([]byte, len(rfbVersion))
+			if _, err := io.ReadFull(browserPeer, version); err != nil {
+				return
+			}
+			browserPeer.Write([]byte(test.browserVersion))
+			offered := make([]byte, 2)
+			if _, err := io.ReadFull(browserPeer, offered); err != nil {
+				return
+			}
+			offers <- offered
+			browserPeer.Write([]byte{test.browserType})
+		}()
+
+		err := vncAuthenticate(browser, server, password)
+		if test.err == "" {
+			// wait for the fake server to read the response before the pipes are closed
+			response := <-responses
+			if err != nil {
+				t.Errorf("%s: %v", test.name, err)
+			} else if !bytes.Equal(response, vncAuthResponse(password, challenge)) {
+				t.Errorf("%s: server got response %x", test.name, response)
+			}
+			if offered := <-offers; !bytes.Equal(offered, []byte{1, rfbSecurityNone}) {
+				t.Errorf("%s: browser was offered %v, want only no authentication", test.name, offered)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error = %v, want %q", test.name, err, test.err)
+		}
+
+		browser.Close()
+		browserPeer.Close()
+		server.Close()
+		serverPeer.Close()
+	}
+}
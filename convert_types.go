@@ -1,6 +1,13 @@
 package goproxmox
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // boolToString returns "1" for true and "0" for false
 func boolToString(b bool) string {
@@ -409,3 +416,71 @@ func TimeValueMap(src map[string]*time.Time) map[string]time.Time {
 	}
 	return dst
 }
+
+// interfaceToInt converts a decoded JSON number or a numeric string to an int.
+// It returns 0 for everything else.
+func interfaceToInt(v interface{}) int {
+	switch value := v.(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	case string:
+		i, _ := strconv.Atoi(value)
+		return i
+	}
+	return 0
+}
+
+// interfaceToString converts a decoded JSON value to its string representation
+// as used by the Proxmox API.
+func interfaceToString(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// unmarshalLenientNumbers decodes the JSON object data into the struct pointed to by v. Numeric fields also
+// accept numbers sent as strings, as some PVE versions do, and empty strings as zero.
+func unmarshalLenientNumbers(data []byte, v interface{}) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch field.Type.Kind() {
+		case reflect.Int, reflect.Int64, reflect.Uint64, reflect.Float64:
+		default:
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		value, ok := raw[name]
+		if !ok || len(value) == 0 || value[0] != '"' {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			continue
+		}
+		if s == "" {
+			delete(raw, name)
+		} else if _, err := strconv.ParseFloat(s, 64); err == nil {
+			raw[name] = json.RawMessage(s)
+		}
+	}
+
+	normalized, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(normalized, v)
+}
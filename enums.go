@@ -1,477 +1,1707 @@
+// Code generated by gen_enums.go; DO NOT EDIT.
+
 package goproxmox
 
 import "fmt"
 
-type Bios int
+// Bios is an open enum: values unknown to this library are kept verbatim.
+type Bios string
 
 const (
-	BIOS_SeaBIOS Bios = 1 + iota
-	BIOS_OVMF
+	BIOS_SeaBIOS Bios = "seabios"
+	BIOS_OVMF    Bios = "ovmf"
 )
 
-var biosValues = [...]string{
-	"seabios",
-	"ovmf",
+var biosValues = [...]Bios{
+	BIOS_SeaBIOS,
+	BIOS_OVMF,
 }
 
 // String returns the name of the Bios.
-func (m Bios) String() string { return biosValues[m-1] }
+func (m Bios) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the Bios values known to this library.
+func (m Bios) IsKnown() bool {
+	for _, v := range biosValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m Bios) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *Bios) UnmarshalText(text []byte) error {
+	*m = Bios(text)
+	return nil
+}
 
+// BiosFromString returns s as Bios. If s is not a known value it is still
+// returned verbatim, together with an error.
 func BiosFromString(s string) (Bios, error) {
+	m := Bios(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to Bios values", s)
+	}
+	return m, nil
+}
+
+// BiosValues returns all Bios values known to this library.
+func BiosValues() []Bios {
+	return append([]Bios(nil), biosValues[:]...)
+}
+
+// knownValues returns the names of all known Bios values for the JSON schema.
+func (Bios) knownValues() []string {
+	values := make([]string, len(biosValues))
 	for i, v := range biosValues {
-		if s == v {
-			return Bios(i + 1), nil
-		}
+		values[i] = string(v)
 	}
-	return 0, fmt.Errorf("%s does not belong to Bios values", s)
+	return values
 }
 
-type BootDevice int
+// BootDevice is an open enum: values unknown to this library are kept verbatim.
+type BootDevice string
 
 const (
-	BOOT_Floppy BootDevice = 1 + iota
-	BOOT_HardDisk
-	BOOT_CDROM
-	BOOT_Network
+	BOOT_Floppy   BootDevice = "a"
+	BOOT_HardDisk BootDevice = "c"
+	BOOT_CDROM    BootDevice = "d"
+	BOOT_Network  BootDevice = "n"
 )
 
-var bootDeviceValues = [...]string{
-	"a",
-	"c",
-	"d",
-	"n",
+var bootDeviceValues = [...]BootDevice{
+	BOOT_Floppy,
+	BOOT_HardDisk,
+	BOOT_CDROM,
+	BOOT_Network,
 }
 
 // String returns the name of the BootDevice.
-func (m BootDevice) String() string { return bootDeviceValues[m-1] }
+func (m BootDevice) String() string { return string(m) }
 
+// IsKnown reports whether m is one of the BootDevice values known to this library.
+func (m BootDevice) IsKnown() bool {
+	for _, v := range bootDeviceValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m BootDevice) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *BootDevice) UnmarshalText(text []byte) error {
+	*m = BootDevice(text)
+	return nil
+}
+
+// BootDeviceFromString returns s as BootDevice. If s is not a known value it is still
+// returned verbatim, together with an error.
 func BootDeviceFromString(s string) (BootDevice, error) {
+	m := BootDevice(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to BootDevice values", s)
+	}
+	return m, nil
+}
+
+// BootDeviceValues returns all BootDevice values known to this library.
+func BootDeviceValues() []BootDevice {
+	return append([]BootDevice(nil), bootDeviceValues[:]...)
+}
+
+// knownValues returns the names of all known BootDevice values for the JSON schema.
+func (BootDevice) knownValues() []string {
+	values := make([]string, len(bootDeviceValues))
 	for i, v := range bootDeviceValues {
-		if s == v {
-			return BootDevice(i + 1), nil
-		}
+		values[i] = string(v)
 	}
-	return 0, fmt.Errorf("%s does not belong to BootDevice values", s)
+	return values
 }
 
-type CPUType int
+// CPUType is an open enum: values unknown to this library are kept verbatim.
+type CPUType string
 
 const (
-	CPU_486 CPUType = 1 + iota
-	CPU_Broadwell
-	CPU_Broadwell_noTSX
-	CPU_Conroe
-	CPU_Haswell
-	CPU_Haswell_noTSX
-	CPU_IvyBridge
-	CPU_Nehalem
-	CPU_Opteron_G1
-	CPU_Opteron_G2
-	CPU_Opteron_G3
-	CPU_Opteron_G4
-	CPU_Opteron_G5
-	CPU_Penryn
-	CPU_SandyBridge
-	CPU_Skylake_Client
-	CPU_Westmere
-	CPU_Athlon
-	CPU_Core2duo
-	CPU_CoreDuo
-	CPU_HOST
-	CPU_KVM32
-	CPU_KVM64
-	CPU_Pentium
-	CPU_Pentium2
-	CPU_Pentium3
-	CPU_Phenom
-	CPU_Qemu32
-	CPU_Qemu64
+	CPU_486                       CPUType = "486"
+	CPU_Broadwell                 CPUType = "Broadwell"
+	CPU_Broadwell_IBRS            CPUType = "Broadwell-IBRS"
+	CPU_Broadwell_noTSX           CPUType = "Broadwell-noTSX"
+	CPU_Broadwell_noTSX_IBRS      CPUType = "Broadwell-noTSX-IBRS"
+	CPU_Cascadelake_Server        CPUType = "Cascadelake-Server"
+	CPU_Cascadelake_Server_noTSX  CPUType = "Cascadelake-Server-noTSX"
+	CPU_Conroe                    CPUType = "Conroe"
+	CPU_Cooperlake                CPUType = "Cooperlake"
+	CPU_EPYC                      CPUType = "EPYC"
+	CPU_EPYC_IBPB                 CPUType = "EPYC-IBPB"
+	CPU_EPYC_Rome                 CPUType = "EPYC-Rome"
+	CPU_EPYC_Milan                CPUType = "EPYC-Milan"
+	CPU_EPYC_Genoa                CPUType = "EPYC-Genoa"
+	CPU_Haswell                   CPUType = "Haswell"
+	CPU_Haswell_IBRS              CPUType = "Haswell-IBRS"
+	CPU_Haswell_noTSX             CPUType = "Haswell-noTSX"
+	CPU_Haswell_noTSX_IBRS        CPUType = "Haswell-noTSX-IBRS"
+	CPU_Icelake_Server            CPUType = "Icelake-Server"
+	CPU_Icelake_Server_noTSX      CPUType = "Icelake-Server-noTSX"
+	CPU_IvyBridge                 CPUType = "IvyBridge"
+	CPU_IvyBridge_IBRS            CPUType = "IvyBridge-IBRS"
+	CPU_KnightsMill               CPUType = "KnightsMill"
+	CPU_Nehalem                   CPUType = "Nehalem"
+	CPU_Nehalem_IBRS              CPUType = "Nehalem-IBRS"
+	CPU_Opteron_G1                CPUType = "Opteron_G1"
+	CPU_Opteron_G2                CPUType = "Opteron_G2"
+	CPU_Opteron_G3                CPUType = "Opteron_G3"
+	CPU_Opteron_G4                CPUType = "Opteron_G4"
+	CPU_Opteron_G5                CPUType = "Opteron_G5"
+	CPU_Penryn                    CPUType = "Penryn"
+	CPU_SandyBridge               CPUType = "SandyBridge"
+	CPU_SandyBridge_IBRS          CPUType = "SandyBridge-IBRS"
+	CPU_SapphireRapids            CPUType = "SapphireRapids"
+	CPU_Skylake_Client            CPUType = "Skylake-Client"
+	CPU_Skylake_Client_IBRS       CPUType = "Skylake-Client-IBRS"
+	CPU_Skylake_Client_noTSX_IBRS CPUType = "Skylake-Client-noTSX-IBRS"
+	CPU_Skylake_Server            CPUType = "Skylake-Server"
+	CPU_Skylake_Server_IBRS       CPUType = "Skylake-Server-IBRS"
+	CPU_Skylake_Server_noTSX_IBRS CPUType = "Skylake-Server-noTSX-IBRS"
+	CPU_Westmere                  CPUType = "Westmere"
+	CPU_Westmere_IBRS             CPUType = "Westmere-IBRS"
+	CPU_Athlon                    CPUType = "athlon"
+	CPU_Core2duo                  CPUType = "core2duo"
+	CPU_CoreDuo                   CPUType = "coreduo"
+	CPU_HOST                      CPUType = "host"
+	CPU_KVM32                     CPUType = "kvm32"
+	CPU_KVM64                     CPUType = "kvm64"
+	CPU_MAX                       CPUType = "max"
+	CPU_Pentium                   CPUType = "pentium"
+	CPU_Pentium2                  CPUType = "pentium2"
+	CPU_Pentium3                  CPUType = "pentium3"
+	CPU_Phenom                    CPUType = "phenom"
+	CPU_Qemu32                    CPUType = "qemu32"
+	CPU_Qemu64                    CPUType = "qemu64"
+	CPU_X86_64_v2                 CPUType = "x86-64-v2"
+	CPU_X86_64_v2_AES             CPUType = "x86-64-v2-AES"
+	CPU_X86_64_v3                 CPUType = "x86-64-v3"
+	CPU_X86_64_v4                 CPUType = "x86-64-v4"
 )
 
-var cpuTypeValues = [...]string{
-	"486",
-	"Broadwell",
-	"Broadwell-noTSX",
-	"Conroe",
-	"Haswell",
-	"Haswell-noTSX",
-	"IvyBridge",
-	"Nehalem",
-	"Opteron_G1",
-	"Opteron_G2",
-	"Opteron_G3",
-	"Opteron_G4",
-	"Opteron_G5",
-	"Penryn",
-	"SandyBridge",
-	"Skylake-Client",
-	"Westmere",
-	"athlon",
-	"core2duo",
-	"coreduo",
-	"host",
-	"kvm32",
-	"kvm64",
-	"pentium",
-	"pentium2",
-	"pentium3",
-	"phenom",
-	"qemu32",
-	"qemu64",
+var cpuTypeValues = [...]CPUType{
+	CPU_486,
+	CPU_Broadwell,
+	CPU_Broadwell_IBRS,
+	CPU_Broadwell_noTSX,
+	CPU_Broadwell_noTSX_IBRS,
+	CPU_Cascadelake_Server,
+	CPU_Cascadelake_Server_noTSX,
+	CPU_Conroe,
+	CPU_Cooperlake,
+	CPU_EPYC,
+	CPU_EPYC_IBPB,
+	CPU_EPYC_Rome,
+	CPU_EPYC_Milan,
+	CPU_EPYC_Genoa,
+	CPU_Haswell,
+	CPU_Haswell_IBRS,
+	CPU_Haswell_noTSX,
+	CPU_Haswell_noTSX_IBRS,
+	CPU_Icelake_Server,
+	CPU_Icelake_Server_noTSX,
+	CPU_IvyBridge,
+	CPU_IvyBridge_IBRS,
+	CPU_KnightsMill,
+	CPU_Nehalem,
+	CPU_Nehalem_IBRS,
+	CPU_Opteron_G1,
+	CPU_Opteron_G2,
+	CPU_Opteron_G3,
+	CPU_Opteron_G4,
+	CPU_Opteron_G5,
+	CPU_Penryn,
+	CPU_SandyBridge,
+	CPU_SandyBridge_IBRS,
+	CPU_SapphireRapids,
+	CPU_Skylake_Client,
+	CPU_Skylake_Client_IBRS,
+	CPU_Skylake_Client_noTSX_IBRS,
+	CPU_Skylake_Server,
+	CPU_Skylake_Server_IBRS,
+	CPU_Skylake_Server_noTSX_IBRS,
+	CPU_Westmere,
+	CPU_Westmere_IBRS,
+	CPU_Athlon,
+	CPU_Core2duo,
+	CPU_CoreDuo,
+	CPU_HOST,
+	CPU_KVM32,
+	CPU_KVM64,
+	CPU_MAX,
+	CPU_Pentium,
+	CPU_Pentium2,
+	CPU_Pentium3,
+	CPU_Phenom,
+	CPU_Qemu32,
+	CPU_Qemu64,
+	CPU_X86_64_v2,
+	CPU_X86_64_v2_AES,
+	CPU_X86_64_v3,
+	CPU_X86_64_v4,
 }
 
 // String returns the name of the CPUType.
-func (m CPUType) String() string { return cpuTypeValues[m-1] }
+func (m CPUType) String() string { return string(m) }
 
-func CPUTypeFromString(s string) (CPUType, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return CPUType(i + 1), nil
+// IsKnown reports whether m is one of the CPUType values known to this library.
+func (m CPUType) IsKnown() bool {
+	for _, v := range cpuTypeValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to CPUType values", s)
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m CPUType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *CPUType) UnmarshalText(text []byte) error {
+	*m = CPUType(text)
+	return nil
+}
+
+// CPUTypeFromString returns s as CPUType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func CPUTypeFromString(s string) (CPUType, error) {
+	m := CPUType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to CPUType values", s)
+	}
+	return m, nil
+}
+
+// CPUTypeValues returns all CPUType values known to this library.
+func CPUTypeValues() []CPUType {
+	return append([]CPUType(nil), cpuTypeValues[:]...)
+}
+
+// knownValues returns the names of all known CPUType values for the JSON schema.
+func (CPUType) knownValues() []string {
+	values := make([]string, len(cpuTypeValues))
+	for i, v := range cpuTypeValues {
+		values[i] = string(v)
+	}
+	return values
 }
 
-type HugePages int
+// HugePages is an open enum: values unknown to this library are kept verbatim.
+type HugePages string
 
 const (
-	HugePages_1024 HugePages = 1 + iota
-	HugePages_2
-	HugePages_ANY
+	HugePages_1024 HugePages = "1024"
+	HugePages_2    HugePages = "2"
+	HugePages_ANY  HugePages = "any"
 )
 
-var hugePagesValues = [...]string{
-	"1024",
-	"2",
-	"any",
+var hugePagesValues = [...]HugePages{
+	HugePages_1024,
+	HugePages_2,
+	HugePages_ANY,
 }
 
 // String returns the name of the HugePages.
-func (m HugePages) String() string { return hugePagesValues[m-1] }
+func (m HugePages) String() string { return string(m) }
 
-func HugePagesFromString(s string) (HugePages, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return HugePages(i + 1), nil
+// IsKnown reports whether m is one of the HugePages values known to this library.
+func (m HugePages) IsKnown() bool {
+	for _, v := range hugePagesValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to HugePages values", s)
+	return false
 }
 
-type KeyboardLayout int
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m HugePages) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *HugePages) UnmarshalText(text []byte) error {
+	*m = HugePages(text)
+	return nil
+}
+
+// HugePagesFromString returns s as HugePages. If s is not a known value it is still
+// returned verbatim, together with an error.
+func HugePagesFromString(s string) (HugePages, error) {
+	m := HugePages(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to HugePages values", s)
+	}
+	return m, nil
+}
+
+// HugePagesValues returns all HugePages values known to this library.
+func HugePagesValues() []HugePages {
+	return append([]HugePages(nil), hugePagesValues[:]...)
+}
+
+// knownValues returns the names of all known HugePages values for the JSON schema.
+func (HugePages) knownValues() []string {
+	values := make([]string, len(hugePagesValues))
+	for i, v := range hugePagesValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// KeyboardLayout is an open enum: values unknown to this library are kept verbatim.
+type KeyboardLayout string
 
 const (
-	KeyboardLayout_DA KeyboardLayout = 1 + iota
-	KeyboardLayout_DE
-	KeyboardLayout_DE_CH
-	KeyboardLayout_EN_GB
-	KeyboardLayout_EN_US
-	KeyboardLayout_ES
-	KeyboardLayout_FI
-	KeyboardLayout_FR
-	KeyboardLayout_FR_BE
-	KeyboardLayout_FR_CA
-	KeyboardLayout_FR_CH
-	KeyboardLayout_HU
-	KeyboardLayout_IS
-	KeyboardLayout_IT
-	KeyboardLayout_JA
-	KeyboardLayout_LT
-	KeyboardLayout_MK
-	KeyboardLayout_NL
-	KeyboardLayout_NO
-	KeyboardLayout_PL
-	KeyboardLayout_PT
-	KeyboardLayout_PT_BR
-	KeyboardLayout_SL
-	KeyboardLayout_SV
-	KeyboardLayout_TR
+	KeyboardLayout_DA    KeyboardLayout = "da"
+	KeyboardLayout_DE    KeyboardLayout = "de"
+	KeyboardLayout_DE_CH KeyboardLayout = "de-ch"
+	KeyboardLayout_EN_GB KeyboardLayout = "en-gb"
+	KeyboardLayout_EN_US KeyboardLayout = "en-us"
+	KeyboardLayout_ES    KeyboardLayout = "es"
+	KeyboardLayout_FI    KeyboardLayout = "fi"
+	KeyboardLayout_FR    KeyboardLayout = "fr"
+	KeyboardLayout_FR_BE KeyboardLayout = "fr-be"
+	KeyboardLayout_FR_CA KeyboardLayout = "fr-ca"
+	KeyboardLayout_FR_CH KeyboardLayout = "fr-ch"
+	KeyboardLayout_HU    KeyboardLayout = "hu"
+	KeyboardLayout_IS    KeyboardLayout = "is"
+	KeyboardLayout_IT    KeyboardLayout = "it"
+	KeyboardLayout_JA    KeyboardLayout = "ja"
+	KeyboardLayout_LT    KeyboardLayout = "lt"
+	KeyboardLayout_MK    KeyboardLayout = "mk"
+	KeyboardLayout_NL    KeyboardLayout = "nl"
+	KeyboardLayout_NO    KeyboardLayout = "no"
+	KeyboardLayout_PL    KeyboardLayout = "pl"
+	KeyboardLayout_PT    KeyboardLayout = "pt"
+	KeyboardLayout_PT_BR KeyboardLayout = "pt-br"
+	KeyboardLayout_SL    KeyboardLayout = "sl"
+	KeyboardLayout_SV    KeyboardLayout = "sv"
+	KeyboardLayout_TR    KeyboardLayout = "tr"
 )
 
-var keyboardLayoutValues = [...]string{
-	"da",
-	"de",
-	"de-ch",
-	"en-gb",
-	"en-us",
-	"es",
-	"fi",
-	"fr",
-	"fr-be",
-	"fr-ca",
-	"fr-ch",
-	"hu",
-	"is",
-	"it",
-	"ja",
-	"lt",
-	"mk",
-	"nl",
-	"no",
-	"pl",
-	"pt",
-	"pt-br",
-	"sl",
-	"sv",
-	"tr",
+var keyboardLayoutValues = [...]KeyboardLayout{
+	KeyboardLayout_DA,
+	KeyboardLayout_DE,
+	KeyboardLayout_DE_CH,
+	KeyboardLayout_EN_GB,
+	KeyboardLayout_EN_US,
+	KeyboardLayout_ES,
+	KeyboardLayout_FI,
+	KeyboardLayout_FR,
+	KeyboardLayout_FR_BE,
+	KeyboardLayout_FR_CA,
+	KeyboardLayout_FR_CH,
+	KeyboardLayout_HU,
+	KeyboardLayout_IS,
+	KeyboardLayout_IT,
+	KeyboardLayout_JA,
+	KeyboardLayout_LT,
+	KeyboardLayout_MK,
+	KeyboardLayout_NL,
+	KeyboardLayout_NO,
+	KeyboardLayout_PL,
+	KeyboardLayout_PT,
+	KeyboardLayout_PT_BR,
+	KeyboardLayout_SL,
+	KeyboardLayout_SV,
+	KeyboardLayout_TR,
 }
 
 // String returns the name of the KeyboardLayout.
-func (m KeyboardLayout) String() string { return keyboardLayoutValues[m-1] }
+func (m KeyboardLayout) String() string { return string(m) }
 
-func KeyboardLayoutFromString(s string) (KeyboardLayout, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return KeyboardLayout(i + 1), nil
+// IsKnown reports whether m is one of the KeyboardLayout values known to this library.
+func (m KeyboardLayout) IsKnown() bool {
+	for _, v := range keyboardLayoutValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to KeyboardLayout values", s)
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m KeyboardLayout) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *KeyboardLayout) UnmarshalText(text []byte) error {
+	*m = KeyboardLayout(text)
+	return nil
+}
+
+// KeyboardLayoutFromString returns s as KeyboardLayout. If s is not a known value it is still
+// returned verbatim, together with an error.
+func KeyboardLayoutFromString(s string) (KeyboardLayout, error) {
+	m := KeyboardLayout(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to KeyboardLayout values", s)
+	}
+	return m, nil
 }
 
-type Lock int
+// KeyboardLayoutValues returns all KeyboardLayout values known to this library.
+func KeyboardLayoutValues() []KeyboardLayout {
+	return append([]KeyboardLayout(nil), keyboardLayoutValues[:]...)
+}
+
+// knownValues returns the names of all known KeyboardLayout values for the JSON schema.
+func (KeyboardLayout) knownValues() []string {
+	values := make([]string, len(keyboardLayoutValues))
+	for i, v := range keyboardLayoutValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// Lock is an open enum: values unknown to this library are kept verbatim.
+type Lock string
 
 const (
-	Lock_Migrate Lock = 1 + iota
-	Lock_Backup
-	Lock_Snapshot
-	Lock_Rollback
+	Lock_Backup         Lock = "backup"
+	Lock_Clone          Lock = "clone"
+	Lock_Create         Lock = "create"
+	Lock_Migrate        Lock = "migrate"
+	Lock_Rollback       Lock = "rollback"
+	Lock_Snapshot       Lock = "snapshot"
+	Lock_SnapshotDelete Lock = "snapshot-delete"
+	Lock_Suspending     Lock = "suspending"
+	Lock_Suspended      Lock = "suspended"
 )
 
-var lockValues = [...]string{
-	"migrate",
-	"backup",
-	"snapshot",
-	"rollback",
+var lockValues = [...]Lock{
+	Lock_Backup,
+	Lock_Clone,
+	Lock_Create,
+	Lock_Migrate,
+	Lock_Rollback,
+	Lock_Snapshot,
+	Lock_SnapshotDelete,
+	Lock_Suspending,
+	Lock_Suspended,
 }
 
 // String returns the name of the Lock.
-func (m Lock) String() string { return lockValues[m-1] }
+func (m Lock) String() string { return string(m) }
 
-func LockFromString(s string) (Lock, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return Lock(i + 1), nil
+// IsKnown reports whether m is one of the Lock values known to this library.
+func (m Lock) IsKnown() bool {
+	for _, v := range lockValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to Lock values", s)
+	return false
 }
 
-type OSType int
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m Lock) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *Lock) UnmarshalText(text []byte) error {
+	*m = Lock(text)
+	return nil
+}
+
+// LockFromString returns s as Lock. If s is not a known value it is still
+// returned verbatim, together with an error.
+func LockFromString(s string) (Lock, error) {
+	m := Lock(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to Lock values", s)
+	}
+	return m, nil
+}
+
+// LockValues returns all Lock values known to this library.
+func LockValues() []Lock {
+	return append([]Lock(nil), lockValues[:]...)
+}
+
+// knownValues returns the names of all known Lock values for the JSON schema.
+func (Lock) knownValues() []string {
+	values := make([]string, len(lockValues))
+	for i, v := range lockValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// OSType is an open enum: values unknown to this library are kept verbatim.
+type OSType string
 
 const (
-	OS_Unspecified OSType = 1 + iota
-	OS_WindowsXP
-	OS_Windows2000
-	OS_Windows2003
-	OS_Windows2008
-	OS_WindowsVista
-	OS_Windows7
-	OS_Windows8_2012
-	OS_Linux24
-	OS_Linux26_3X
-	OS_Solaris
+	OS_Unspecified         OSType = "other"
+	OS_WindowsXP           OSType = "wxp"
+	OS_Windows2000         OSType = "w2k"
+	OS_Windows2003         OSType = "w2k3"
+	OS_Windows2008         OSType = "w2k8"
+	OS_WindowsVista        OSType = "wvista"
+	OS_Windows7            OSType = "win7"
+	OS_Windows8_2012       OSType = "win8"
+	OS_Windows10_2016_2019 OSType = "win10"
+	OS_Windows11_2022      OSType = "win11"
+	OS_Linux24             OSType = "l24"
+	OS_Linux26_3X          OSType = "l26"
+	OS_Solaris             OSType = "solaris"
 )
 
-var osTypeValues = [...]string{
-	"other",
-	"wxp",
-	"w2k",
-	"w2k3",
-	"w2k8",
-	"wvista",
-	"win7",
-	"win8",
-	"l24",
-	"l26",
-	"solaris",
+var osTypeValues = [...]OSType{
+	OS_Unspecified,
+	OS_WindowsXP,
+	OS_Windows2000,
+	OS_Windows2003,
+	OS_Windows2008,
+	OS_WindowsVista,
+	OS_Windows7,
+	OS_Windows8_2012,
+	OS_Windows10_2016_2019,
+	OS_Windows11_2022,
+	OS_Linux24,
+	OS_Linux26_3X,
+	OS_Solaris,
 }
 
 // String returns the name of the OSType.
-func (m OSType) String() string { return osTypeValues[m-1] }
+func (m OSType) String() string { return string(m) }
 
-func OSTypeFromString(s string) (OSType, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return OSType(i + 1), nil
+// IsKnown reports whether m is one of the OSType values known to this library.
+func (m OSType) IsKnown() bool {
+	for _, v := range osTypeValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to OSType values", s)
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m OSType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *OSType) UnmarshalText(text []byte) error {
+	*m = OSType(text)
+	return nil
+}
+
+// OSTypeFromString returns s as OSType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func OSTypeFromString(s string) (OSType, error) {
+	m := OSType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to OSType values", s)
+	}
+	return m, nil
+}
+
+// OSTypeValues returns all OSType values known to this library.
+func OSTypeValues() []OSType {
+	return append([]OSType(nil), osTypeValues[:]...)
+}
+
+// knownValues returns the names of all known OSType values for the JSON schema.
+func (OSType) knownValues() []string {
+	values := make([]string, len(osTypeValues))
+	for i, v := range osTypeValues {
+		values[i] = string(v)
+	}
+	return values
 }
 
-type SCSIControllerType int
+// SCSIControllerType is an open enum: values unknown to this library are kept verbatim.
+type SCSIControllerType string
 
 const (
-	SCSI_LSI SCSIControllerType = 1 + iota
-	SCSI_LSI53C810
-	SCSI_VirtIO_SCSI_PCI
-	SCSI_VirtIO_SCSI_SINGLE
-	SCSI_MEGASAS
-	SCSI_PVSCSI
+	SCSI_LSI                SCSIControllerType = "lsi"
+	SCSI_LSI53C810          SCSIControllerType = "lsi53c810"
+	SCSI_VirtIO_SCSI_PCI    SCSIControllerType = "virtio-scsi-pci"
+	SCSI_VirtIO_SCSI_SINGLE SCSIControllerType = "virtio-scsi-single"
+	SCSI_MEGASAS            SCSIControllerType = "megasas"
+	SCSI_PVSCSI             SCSIControllerType = "pvscsi"
 )
 
-var scsiControllerTypeValues = [...]string{
-	"lsi",
-	"lsi53c810",
-	"virtio-scsi-pci",
-	"virtio-scsi-single",
-	"megasas",
-	"pvscsi",
+var scsiControllerTypeValues = [...]SCSIControllerType{
+	SCSI_LSI,
+	SCSI_LSI53C810,
+	SCSI_VirtIO_SCSI_PCI,
+	SCSI_VirtIO_SCSI_SINGLE,
+	SCSI_MEGASAS,
+	SCSI_PVSCSI,
 }
 
 // String returns the name of the SCSIControllerType.
-func (m SCSIControllerType) String() string { return scsiControllerTypeValues[m-1] }
+func (m SCSIControllerType) String() string { return string(m) }
 
-func SCSIControllerTypeFromString(s string) (SCSIControllerType, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return SCSIControllerType(i + 1), nil
+// IsKnown reports whether m is one of the SCSIControllerType values known to this library.
+func (m SCSIControllerType) IsKnown() bool {
+	for _, v := range scsiControllerTypeValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to SCSIControllerType values", s)
+	return false
 }
 
-type VGAType int
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m SCSIControllerType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *SCSIControllerType) UnmarshalText(text []byte) error {
+	*m = SCSIControllerType(text)
+	return nil
+}
+
+// SCSIControllerTypeFromString returns s as SCSIControllerType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func SCSIControllerTypeFromString(s string) (SCSIControllerType, error) {
+	m := SCSIControllerType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to SCSIControllerType values", s)
+	}
+	return m, nil
+}
+
+// SCSIControllerTypeValues returns all SCSIControllerType values known to this library.
+func SCSIControllerTypeValues() []SCSIControllerType {
+	return append([]SCSIControllerType(nil), scsiControllerTypeValues[:]...)
+}
+
+// knownValues returns the names of all known SCSIControllerType values for the JSON schema.
+func (SCSIControllerType) knownValues() []string {
+	values := make([]string, len(scsiControllerTypeValues))
+	for i, v := range scsiControllerTypeValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// VGAType is an open enum: values unknown to this library are kept verbatim.
+type VGAType string
 
 const (
-	VGA_Cirrus VGAType = 1 + iota
-	VGA_QXL
-	VGA_QXL2
-	VGA_QXL3
-	VGA_QXL4
-	VGA_Serial0
-	VGA_Serial1
-	VGA_Serial2
-	VGA_Serial3
-	VGA_std
-	VGA_VMWare
+	VGA_Cirrus    VGAType = "cirrus"
+	VGA_None      VGAType = "none"
+	VGA_QXL       VGAType = "qxl"
+	VGA_QXL2      VGAType = "qxl2"
+	VGA_QXL3      VGAType = "qxl3"
+	VGA_QXL4      VGAType = "qxl4"
+	VGA_Serial0   VGAType = "serial0"
+	VGA_Serial1   VGAType = "serial1"
+	VGA_Serial2   VGAType = "serial2"
+	VGA_Serial3   VGAType = "serial3"
+	VGA_std       VGAType = "std"
+	VGA_VirtIO    VGAType = "virtio"
+	VGA_VirtIO_GL VGAType = "virtio-gl"
+	VGA_VMWare    VGAType = "vmware"
 )
 
-var vgaTypeValues = [...]string{
-	"cirrus",
-	"qxl",
-	"qxl2",
-	"qxl3",
-	"qxl4",
-	"serial0",
-	"serial1",
-	"serial2",
-	"serial3",
-	"std",
-	"vmware",
+var vgaTypeValues = [...]VGAType{
+	VGA_Cirrus,
+	VGA_None,
+	VGA_QXL,
+	VGA_QXL2,
+	VGA_QXL3,
+	VGA_QXL4,
+	VGA_Serial0,
+	VGA_Serial1,
+	VGA_Serial2,
+	VGA_Serial3,
+	VGA_std,
+	VGA_VirtIO,
+	VGA_VirtIO_GL,
+	VGA_VMWare,
 }
 
 // String returns the name of the VGAType.
-func (m VGAType) String() string { return vgaTypeValues[m-1] }
+func (m VGAType) String() string { return string(m) }
 
-func VGATypeFromString(s string) (VGAType, error) {
-	for i, v := range bootDeviceValues {
-		if s == v {
-			return VGAType(i + 1), nil
+// IsKnown reports whether m is one of the VGAType values known to this library.
+func (m VGAType) IsKnown() bool {
+	for _, v := range vgaTypeValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to VGAType values", s)
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m VGAType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *VGAType) UnmarshalText(text []byte) error {
+	*m = VGAType(text)
+	return nil
+}
+
+// VGATypeFromString returns s as VGAType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func VGATypeFromString(s string) (VGAType, error) {
+	m := VGAType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to VGAType values", s)
+	}
+	return m, nil
 }
 
-type NetworkCardModel int
+// VGATypeValues returns all VGAType values known to this library.
+func VGATypeValues() []VGAType {
+	return append([]VGAType(nil), vgaTypeValues[:]...)
+}
+
+// knownValues returns the names of all known VGAType values for the JSON schema.
+func (VGAType) knownValues() []string {
+	values := make([]string, len(vgaTypeValues))
+	for i, v := range vgaTypeValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// NetworkCardModel is an open enum: values unknown to this library are kept verbatim.
+type NetworkCardModel string
 
 const (
-	NetworkCard_E1000 NetworkCardModel = 1 + iota
-	NetworkCard_E1000_82540em
-	NetworkCard_E1000_82544gc
-	NetworkCard_E1000_82545em
-	NetworkCard_I82551
-	NetworkCard_I82557b
-	NetworkCard_I82559er
-	NetworkCard_NE2K_ISA
-	NetworkCard_NE2K_PCI
-	NetworkCard_PCNET
-	NetworkCard_RTL8139
-	NetworkCard_VIRTIO
-	NetworkCard_VMXNET3
+	NetworkCard_E1000         NetworkCardModel = "e1000"
+	NetworkCard_E1000_82540em NetworkCardModel = "e1000-82540em"
+	NetworkCard_E1000_82544gc NetworkCardModel = "e1000-82544gc"
+	NetworkCard_E1000_82545em NetworkCardModel = "e1000-82545em"
+	NetworkCard_E1000E        NetworkCardModel = "e1000e"
+	NetworkCard_I82551        NetworkCardModel = "i82551"
+	NetworkCard_I82557b       NetworkCardModel = "i82557b"
+	NetworkCard_I82559er      NetworkCardModel = "i82559er"
+	NetworkCard_NE2K_ISA      NetworkCardModel = "ne2k_isa"
+	NetworkCard_NE2K_PCI      NetworkCardModel = "ne2k_pci"
+	NetworkCard_PCNET         NetworkCardModel = "pcnet"
+	NetworkCard_RTL8139       NetworkCardModel = "rtl8139"
+	NetworkCard_VIRTIO        NetworkCardModel = "virtio"
+	NetworkCard_VMXNET3       NetworkCardModel = "vmxnet3"
 )
 
-var networkCardModelValues = [...]string{
-	"e1000",
-	"e1000-82540em",
-	"e1000-82544gc",
-	"e1000-82545em",
-	"i82551",
-	"i82557b",
-	"i82559er",
-	"ne2k_isa",
-	"ne2k_pci",
-	"pcnet",
-	"rtl8139",
-	"virtio",
-	"vmxnet3",
+var networkCardModelValues = [...]NetworkCardModel{
+	NetworkCard_E1000,
+	NetworkCard_E1000_82540em,
+	NetworkCard_E1000_82544gc,
+	NetworkCard_E1000_82545em,
+	NetworkCard_E1000E,
+	NetworkCard_I82551,
+	NetworkCard_I82557b,
+	NetworkCard_I82559er,
+	NetworkCard_NE2K_ISA,
+	NetworkCard_NE2K_PCI,
+	NetworkCard_PCNET,
+	NetworkCard_RTL8139,
+	NetworkCard_VIRTIO,
+	NetworkCard_VMXNET3,
 }
 
 // String returns the name of the NetworkCardModel.
-func (m NetworkCardModel) String() string { return networkCardModelValues[m-1] }
+func (m NetworkCardModel) String() string { return string(m) }
 
+// IsKnown reports whether m is one of the NetworkCardModel values known to this library.
+func (m NetworkCardModel) IsKnown() bool {
+	for _, v := range networkCardModelValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m NetworkCardModel) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *NetworkCardModel) UnmarshalText(text []byte) error {
+	*m = NetworkCardModel(text)
+	return nil
+}
+
+// NetworkCardModelFromString returns s as NetworkCardModel. If s is not a known value it is still
+// returned verbatim, together with an error.
 func NetworkCardModelFromString(s string) (NetworkCardModel, error) {
+	m := NetworkCardModel(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to NetworkCardModel values", s)
+	}
+	return m, nil
+}
+
+// NetworkCardModelValues returns all NetworkCardModel values known to this library.
+func NetworkCardModelValues() []NetworkCardModel {
+	return append([]NetworkCardModel(nil), networkCardModelValues[:]...)
+}
+
+// knownValues returns the names of all known NetworkCardModel values for the JSON schema.
+func (NetworkCardModel) knownValues() []string {
+	values := make([]string, len(networkCardModelValues))
 	for i, v := range networkCardModelValues {
-		if s == v {
-			return NetworkCardModel(i + 1), nil
-		}
+		values[i] = string(v)
 	}
-	return 0, fmt.Errorf("%s does not belong to NetworkCardModel values", s)
+	return values
 }
 
-type VolumeFormat int
+// VolumeFormat is an open enum: values unknown to this library are kept verbatim.
+type VolumeFormat string
 
 const (
-	VolumeFormat_CLOOP VolumeFormat = 1 + iota
-	VolumeFormat_COW
-	VolumeFormat_QCOW
-	VolumeFormat_QCOW2
-	VolumeFormat_QED
-	VolumeFormat_RAW
-	VolumeFormat_VMDK
+	VolumeFormat_CLOOP VolumeFormat = "cloop"
+	VolumeFormat_COW   VolumeFormat = "cow"
+	VolumeFormat_QCOW  VolumeFormat = "qcow"
+	VolumeFormat_QCOW2 VolumeFormat = "qcow2"
+	VolumeFormat_QED   VolumeFormat = "qed"
+	VolumeFormat_RAW   VolumeFormat = "raw"
+	VolumeFormat_VMDK  VolumeFormat = "vmdk"
 )
 
-var volumeFormatValues = [...]string{
-	"cloop",
-	"cow",
-	"qcow",
-	"qcow2",
-	"qed",
-	"raw",
-	"vmdk",
+var volumeFormatValues = [...]VolumeFormat{
+	VolumeFormat_CLOOP,
+	VolumeFormat_COW,
+	VolumeFormat_QCOW,
+	VolumeFormat_QCOW2,
+	VolumeFormat_QED,
+	VolumeFormat_RAW,
+	VolumeFormat_VMDK,
 }
 
 // String returns the name of the VolumeFormat.
-func (m VolumeFormat) String() string { return volumeFormatValues[m-1] }
+func (m VolumeFormat) String() string { return string(m) }
 
+// IsKnown reports whether m is one of the VolumeFormat values known to this library.
+func (m VolumeFormat) IsKnown() bool {
+	for _, v := range volumeFormatValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m VolumeFormat) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *VolumeFormat) UnmarshalText(text []byte) error {
+	*m = VolumeFormat(text)
+	return nil
+}
+
+// VolumeFormatFromString returns s as VolumeFormat. If s is not a known value it is still
+// returned verbatim, together with an error.
 func VolumeFormatFromString(s string) (VolumeFormat, error) {
+	m := VolumeFormat(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to VolumeFormat values", s)
+	}
+	return m, nil
+}
+
+// VolumeFormatValues returns all VolumeFormat values known to this library.
+func VolumeFormatValues() []VolumeFormat {
+	return append([]VolumeFormat(nil), volumeFormatValues[:]...)
+}
+
+// knownValues returns the names of all known VolumeFormat values for the JSON schema.
+func (VolumeFormat) knownValues() []string {
+	values := make([]string, len(volumeFormatValues))
 	for i, v := range volumeFormatValues {
-		if s == v {
-			return VolumeFormat(i + 1), nil
-		}
+		values[i] = string(v)
 	}
-	return 0, fmt.Errorf("%s does not belong to VolumeFormat values", s)
+	return values
 }
 
-type MediaType int
+// MediaType is an open enum: values unknown to this library are kept verbatim.
+type MediaType string
 
 const (
-	MediaType_CDROM MediaType = 1 + iota
-	MediaType_DISK
+	MediaType_CDROM MediaType = "cdrom"
+	MediaType_DISK  MediaType = "disk"
 )
 
-var mediaTypeValues = [...]string{
-	"cdrom",
-	"disk",
+var mediaTypeValues = [...]MediaType{
+	MediaType_CDROM,
+	MediaType_DISK,
 }
 
 // String returns the name of the MediaType.
-func (m MediaType) String() string { return mediaTypeValues[m-1] }
+func (m MediaType) String() string { return string(m) }
 
+// IsKnown reports whether m is one of the MediaType values known to this library.
+func (m MediaType) IsKnown() bool {
+	for _, v := range mediaTypeValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m MediaType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *MediaType) UnmarshalText(text []byte) error {
+	*m = MediaType(text)
+	return nil
+}
+
+// MediaTypeFromString returns s as MediaType. If s is not a known value it is still
+// returned verbatim, together with an error.
 func MediaTypeFromString(s string) (MediaType, error) {
+	m := MediaType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to MediaType values", s)
+	}
+	return m, nil
+}
+
+// MediaTypeValues returns all MediaType values known to this library.
+func MediaTypeValues() []MediaType {
+	return append([]MediaType(nil), mediaTypeValues[:]...)
+}
+
+// knownValues returns the names of all known MediaType values for the JSON schema.
+func (MediaType) knownValues() []string {
+	values := make([]string, len(mediaTypeValues))
 	for i, v := range mediaTypeValues {
-		if s == v {
-			return MediaType(i + 1), nil
+		values[i] = string(v)
+	}
+	return values
+}
+
+// EFIType is an open enum: values unknown to this library are kept verbatim.
+type EFIType string
+
+const (
+	EFIType_2M EFIType = "2m"
+	EFIType_4M EFIType = "4m"
+)
+
+var efiTypeValues = [...]EFIType{
+	EFIType_2M,
+	EFIType_4M,
+}
+
+// String returns the name of the EFIType.
+func (m EFIType) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the EFIType values known to this library.
+func (m EFIType) IsKnown() bool {
+	for _, v := range efiTypeValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m EFIType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *EFIType) UnmarshalText(text []byte) error {
+	*m = EFIType(text)
+	return nil
+}
+
+// EFITypeFromString returns s as EFIType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func EFITypeFromString(s string) (EFIType, error) {
+	m := EFIType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to EFIType values", s)
+	}
+	return m, nil
+}
+
+// EFITypeValues returns all EFIType values known to this library.
+func EFITypeValues() []EFIType {
+	return append([]EFIType(nil), efiTypeValues[:]...)
+}
+
+// knownValues returns the names of all known EFIType values for the JSON schema.
+func (EFIType) knownValues() []string {
+	values := make([]string, len(efiTypeValues))
+	for i, v := range efiTypeValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// TPMVersion is an open enum: values unknown to this library are kept verbatim.
+type TPMVersion string
+
+const (
+	TPMVersion_1_2 TPMVersion = "v1.2"
+	TPMVersion_2_0 TPMVersion = "v2.0"
+)
+
+var tpmVersionValues = [...]TPMVersion{
+	TPMVersion_1_2,
+	TPMVersion_2_0,
+}
+
+// String returns the name of the TPMVersion.
+func (m TPMVersion) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the TPMVersion values known to this library.
+func (m TPMVersion) IsKnown() bool {
+	for _, v := range tpmVersionValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m TPMVersion) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *TPMVersion) UnmarshalText(text []byte) error {
+	*m = TPMVersion(text)
+	return nil
+}
+
+// TPMVersionFromString returns s as TPMVersion. If s is not a known value it is still
+// returned verbatim, together with an error.
+func TPMVersionFromString(s string) (TPMVersion, error) {
+	m := TPMVersion(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to TPMVersion values", s)
+	}
+	return m, nil
+}
+
+// TPMVersionValues returns all TPMVersion values known to this library.
+func TPMVersionValues() []TPMVersion {
+	return append([]TPMVersion(nil), tpmVersionValues[:]...)
+}
+
+// knownValues returns the names of all known TPMVersion values for the JSON schema.
+func (TPMVersion) knownValues() []string {
+	values := make([]string, len(tpmVersionValues))
+	for i, v := range tpmVersionValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// VIOMMU is an open enum: values unknown to this library are kept verbatim.
+type VIOMMU string
+
+const (
+	VIOMMU_Intel  VIOMMU = "intel"
+	VIOMMU_VirtIO VIOMMU = "virtio"
+)
+
+var viommuValues = [...]VIOMMU{
+	VIOMMU_Intel,
+	VIOMMU_VirtIO,
+}
+
+// String returns the name of the VIOMMU.
+func (m VIOMMU) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the VIOMMU values known to this library.
+func (m VIOMMU) IsKnown() bool {
+	for _, v := range viommuValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m VIOMMU) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *VIOMMU) UnmarshalText(text []byte) error {
+	*m = VIOMMU(text)
+	return nil
+}
+
+// VIOMMUFromString returns s as VIOMMU. If s is not a known value it is still
+// returned verbatim, together with an error.
+func VIOMMUFromString(s string) (VIOMMU, error) {
+	m := VIOMMU(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to VIOMMU values", s)
+	}
+	return m, nil
+}
+
+// VIOMMUValues returns all VIOMMU values known to this library.
+func VIOMMUValues() []VIOMMU {
+	return append([]VIOMMU(nil), viommuValues[:]...)
+}
+
+// knownValues returns the names of all known VIOMMU values for the JSON schema.
+func (VIOMMU) knownValues() []string {
+	values := make([]string, len(viommuValues))
+	for i, v := range viommuValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// RNGSource is an open enum: values unknown to this library are kept verbatim.
+type RNGSource string
+
+const (
+	RNGSource_URandom RNGSource = "/dev/urandom"
+	RNGSource_Random  RNGSource = "/dev/random"
+	RNGSource_HWRNG   RNGSource = "/dev/hwrng"
+)
+
+var rngSourceValues = [...]RNGSource{
+	RNGSource_URandom,
+	RNGSource_Random,
+	RNGSource_HWRNG,
+}
+
+// String returns the name of the RNGSource.
+func (m RNGSource) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the RNGSource values known to this library.
+func (m RNGSource) IsKnown() bool {
+	for _, v := range rngSourceValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m RNGSource) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *RNGSource) UnmarshalText(text []byte) error {
+	*m = RNGSource(text)
+	return nil
+}
+
+// RNGSourceFromString returns s as RNGSource. If s is not a known value it is still
+// returned verbatim, together with an error.
+func RNGSourceFromString(s string) (RNGSource, error) {
+	m := RNGSource(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to RNGSource values", s)
+	}
+	return m, nil
+}
+
+// RNGSourceValues returns all RNGSource values known to this library.
+func RNGSourceValues() []RNGSource {
+	return append([]RNGSource(nil), rngSourceValues[:]...)
+}
+
+// knownValues returns the names of all known RNGSource values for the JSON schema.
+func (RNGSource) knownValues() []string {
+	values := make([]string, len(rngSourceValues))
+	for i, v := range rngSourceValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// AudioDeviceModel is an open enum: values unknown to this library are kept verbatim.
+type AudioDeviceModel string
+
+const (
+	AudioDevice_ICH9_Intel_HDA AudioDeviceModel = "ich9-intel-hda"
+	AudioDevice_Intel_HDA      AudioDeviceModel = "intel-hda"
+	AudioDevice_AC97           AudioDeviceModel = "AC97"
+)
+
+var audioDeviceModelValues = [...]AudioDeviceModel{
+	AudioDevice_ICH9_Intel_HDA,
+	AudioDevice_Intel_HDA,
+	AudioDevice_AC97,
+}
+
+// String returns the name of the AudioDeviceModel.
+func (m AudioDeviceModel) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the AudioDeviceModel values known to this library.
+func (m AudioDeviceModel) IsKnown() bool {
+	for _, v := range audioDeviceModelValues {
+		if m == v {
+			return true
 		}
 	}
-	return 0, fmt.Errorf("%s does not belong to MediaType values", s)
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m AudioDeviceModel) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *AudioDeviceModel) UnmarshalText(text []byte) error {
+	*m = AudioDeviceModel(text)
+	return nil
+}
+
+// AudioDeviceModelFromString returns s as AudioDeviceModel. If s is not a known value it is still
+// returned verbatim, together with an error.
+func AudioDeviceModelFromString(s string) (AudioDeviceModel, error) {
+	m := AudioDeviceModel(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to AudioDeviceModel values", s)
+	}
+	return m, nil
+}
+
+// AudioDeviceModelValues returns all AudioDeviceModel values known to this library.
+func AudioDeviceModelValues() []AudioDeviceModel {
+	return append([]AudioDeviceModel(nil), audioDeviceModelValues[:]...)
+}
+
+// knownValues returns the names of all known AudioDeviceModel values for the JSON schema.
+func (AudioDeviceModel) knownValues() []string {
+	values := make([]string, len(audioDeviceModelValues))
+	for i, v := range audioDeviceModelValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// AudioDriver is an open enum: values unknown to this library are kept verbatim.
+type AudioDriver string
+
+const (
+	AudioDriver_Spice AudioDriver = "spice"
+	AudioDriver_None  AudioDriver = "none"
+)
+
+var audioDriverValues = [...]AudioDriver{
+	AudioDriver_Spice,
+	AudioDriver_None,
+}
+
+// String returns the name of the AudioDriver.
+func (m AudioDriver) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the AudioDriver values known to this library.
+func (m AudioDriver) IsKnown() bool {
+	for _, v := range audioDriverValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m AudioDriver) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *AudioDriver) UnmarshalText(text []byte) error {
+	*m = AudioDriver(text)
+	return nil
+}
+
+// AudioDriverFromString returns s as AudioDriver. If s is not a known value it is still
+// returned verbatim, together with an error.
+func AudioDriverFromString(s string) (AudioDriver, error) {
+	m := AudioDriver(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to AudioDriver values", s)
+	}
+	return m, nil
+}
+
+// AudioDriverValues returns all AudioDriver values known to this library.
+func AudioDriverValues() []AudioDriver {
+	return append([]AudioDriver(nil), audioDriverValues[:]...)
+}
+
+// knownValues returns the names of all known AudioDriver values for the JSON schema.
+func (AudioDriver) knownValues() []string {
+	values := make([]string, len(audioDriverValues))
+	for i, v := range audioDriverValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// VideoStreaming is an open enum: values unknown to this library are kept verbatim.
+type VideoStreaming string
+
+const (
+	VideoStreaming_Off    VideoStreaming = "off"
+	VideoStreaming_All    VideoStreaming = "all"
+	VideoStreaming_Filter VideoStreaming = "filter"
+)
+
+var videoStreamingValues = [...]VideoStreaming{
+	VideoStreaming_Off,
+	VideoStreaming_All,
+	VideoStreaming_Filter,
+}
+
+// String returns the name of the VideoStreaming.
+func (m VideoStreaming) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the VideoStreaming values known to this library.
+func (m VideoStreaming) IsKnown() bool {
+	for _, v := range videoStreamingValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m VideoStreaming) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *VideoStreaming) UnmarshalText(text []byte) error {
+	*m = VideoStreaming(text)
+	return nil
+}
+
+// VideoStreamingFromString returns s as VideoStreaming. If s is not a known value it is still
+// returned verbatim, together with an error.
+func VideoStreamingFromString(s string) (VideoStreaming, error) {
+	m := VideoStreaming(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to VideoStreaming values", s)
+	}
+	return m, nil
+}
+
+// VideoStreamingValues returns all VideoStreaming values known to this library.
+func VideoStreamingValues() []VideoStreaming {
+	return append([]VideoStreaming(nil), videoStreamingValues[:]...)
+}
+
+// knownValues returns the names of all known VideoStreaming values for the JSON schema.
+func (VideoStreaming) knownValues() []string {
+	values := make([]string, len(videoStreamingValues))
+	for i, v := range videoStreamingValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// QemuAgentType is an open enum: values unknown to this library are kept verbatim.
+type QemuAgentType string
+
+const (
+	QemuAgentType_VirtIO QemuAgentType = "virtio"
+	QemuAgentType_ISA    QemuAgentType = "isa"
+)
+
+var qemuAgentTypeValues = [...]QemuAgentType{
+	QemuAgentType_VirtIO,
+	QemuAgentType_ISA,
+}
+
+// String returns the name of the QemuAgentType.
+func (m QemuAgentType) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the QemuAgentType values known to this library.
+func (m QemuAgentType) IsKnown() bool {
+	for _, v := range qemuAgentTypeValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m QemuAgentType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *QemuAgentType) UnmarshalText(text []byte) error {
+	*m = QemuAgentType(text)
+	return nil
+}
+
+// QemuAgentTypeFromString returns s as QemuAgentType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func QemuAgentTypeFromString(s string) (QemuAgentType, error) {
+	m := QemuAgentType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to QemuAgentType values", s)
+	}
+	return m, nil
+}
+
+// QemuAgentTypeValues returns all QemuAgentType values known to this library.
+func QemuAgentTypeValues() []QemuAgentType {
+	return append([]QemuAgentType(nil), qemuAgentTypeValues[:]...)
+}
+
+// knownValues returns the names of all known QemuAgentType values for the JSON schema.
+func (QemuAgentType) knownValues() []string {
+	values := make([]string, len(qemuAgentTypeValues))
+	for i, v := range qemuAgentTypeValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// CloudInitType is an open enum: values unknown to this library are kept verbatim.
+type CloudInitType string
+
+const (
+	CloudInit_NoCloud      CloudInitType = "nocloud"
+	CloudInit_ConfigDrive2 CloudInitType = "configdrive2"
+	CloudInit_OpenNebula   CloudInitType = "opennebula"
+)
+
+var cloudInitTypeValues = [...]CloudInitType{
+	CloudInit_NoCloud,
+	CloudInit_ConfigDrive2,
+	CloudInit_OpenNebula,
+}
+
+// String returns the name of the CloudInitType.
+func (m CloudInitType) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the CloudInitType values known to this library.
+func (m CloudInitType) IsKnown() bool {
+	for _, v := range cloudInitTypeValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m CloudInitType) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *CloudInitType) UnmarshalText(text []byte) error {
+	*m = CloudInitType(text)
+	return nil
+}
+
+// CloudInitTypeFromString returns s as CloudInitType. If s is not a known value it is still
+// returned verbatim, together with an error.
+func CloudInitTypeFromString(s string) (CloudInitType, error) {
+	m := CloudInitType(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to CloudInitType values", s)
+	}
+	return m, nil
+}
+
+// CloudInitTypeValues returns all CloudInitType values known to this library.
+func CloudInitTypeValues() []CloudInitType {
+	return append([]CloudInitType(nil), cloudInitTypeValues[:]...)
+}
+
+// knownValues returns the names of all known CloudInitType values for the JSON schema.
+func (CloudInitType) knownValues() []string {
+	values := make([]string, len(cloudInitTypeValues))
+	for i, v := range cloudInitTypeValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// RRDTimeframe is an open enum: values unknown to this library are kept verbatim.
+type RRDTimeframe string
+
+const (
+	RRDTimeframe_Hour   RRDTimeframe = "hour"
+	RRDTimeframe_Day    RRDTimeframe = "day"
+	RRDTimeframe_Week   RRDTimeframe = "week"
+	RRDTimeframe_Month  RRDTimeframe = "month"
+	RRDTimeframe_Year   RRDTimeframe = "year"
+	RRDTimeframe_Decade RRDTimeframe = "decade"
+)
+
+var rrdTimeframeValues = [...]RRDTimeframe{
+	RRDTimeframe_Hour,
+	RRDTimeframe_Day,
+	RRDTimeframe_Week,
+	RRDTimeframe_Month,
+	RRDTimeframe_Year,
+	RRDTimeframe_Decade,
+}
+
+// String returns the name of the RRDTimeframe.
+func (m RRDTimeframe) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the RRDTimeframe values known to this library.
+func (m RRDTimeframe) IsKnown() bool {
+	for _, v := range rrdTimeframeValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m RRDTimeframe) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *RRDTimeframe) UnmarshalText(text []byte) error {
+	*m = RRDTimeframe(text)
+	return nil
+}
+
+// RRDTimeframeFromString returns s as RRDTimeframe. If s is not a known value it is still
+// returned verbatim, together with an error.
+func RRDTimeframeFromString(s string) (RRDTimeframe, error) {
+	m := RRDTimeframe(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to RRDTimeframe values", s)
+	}
+	return m, nil
+}
+
+// RRDTimeframeValues returns all RRDTimeframe values known to this library.
+func RRDTimeframeValues() []RRDTimeframe {
+	return append([]RRDTimeframe(nil), rrdTimeframeValues[:]...)
+}
+
+// knownValues returns the names of all known RRDTimeframe values for the JSON schema.
+func (RRDTimeframe) knownValues() []string {
+	values := make([]string, len(rrdTimeframeValues))
+	for i, v := range rrdTimeframeValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// RRDConsolidation is an open enum: values unknown to this library are kept verbatim.
+type RRDConsolidation string
+
+const (
+	RRDConsolidation_Average RRDConsolidation = "AVERAGE"
+	RRDConsolidation_Max     RRDConsolidation = "MAX"
+)
+
+var rrdConsolidationValues = [...]RRDConsolidation{
+	RRDConsolidation_Average,
+	RRDConsolidation_Max,
+}
+
+// String returns the name of the RRDConsolidation.
+func (m RRDConsolidation) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the RRDConsolidation values known to this library.
+func (m RRDConsolidation) IsKnown() bool {
+	for _, v := range rrdConsolidationValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m RRDConsolidation) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *RRDConsolidation) UnmarshalText(text []byte) error {
+	*m = RRDConsolidation(text)
+	return nil
+}
+
+// RRDConsolidationFromString returns s as RRDConsolidation. If s is not a known value it is still
+// returned verbatim, together with an error.
+func RRDConsolidationFromString(s string) (RRDConsolidation, error) {
+	m := RRDConsolidation(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to RRDConsolidation values", s)
+	}
+	return m, nil
+}
+
+// RRDConsolidationValues returns all RRDConsolidation values known to this library.
+func RRDConsolidationValues() []RRDConsolidation {
+	return append([]RRDConsolidation(nil), rrdConsolidationValues[:]...)
+}
+
+// knownValues returns the names of all known RRDConsolidation values for the JSON schema.
+func (RRDConsolidation) knownValues() []string {
+	values := make([]string, len(rrdConsolidationValues))
+	for i, v := range rrdConsolidationValues {
+		values[i] = string(v)
+	}
+	return values
+}
+
+// VMFeature is an open enum: values unknown to this library are kept verbatim.
+type VMFeature string
+
+const (
+	VMFeature_Snapshot VMFeature = "snapshot"
+	VMFeature_Clone    VMFeature = "clone"
+	VMFeature_Copy     VMFeature = "copy"
+)
+
+var vmFeatureValues = [...]VMFeature{
+	VMFeature_Snapshot,
+	VMFeature_Clone,
+	VMFeature_Copy,
+}
+
+// String returns the name of the VMFeature.
+func (m VMFeature) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the VMFeature values known to this library.
+func (m VMFeature) IsKnown() bool {
+	for _, v := range vmFeatureValues {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m VMFeature) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *VMFeature) UnmarshalText(text []byte) error {
+	*m = VMFeature(text)
+	return nil
+}
+
+// VMFeatureFromString returns s as VMFeature. If s is not a known value it is still
+// returned verbatim, together with an error.
+func VMFeatureFromString(s string) (VMFeature, error) {
+	m := VMFeature(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to VMFeature values", s)
+	}
+	return m, nil
+}
+
+// VMFeatureValues returns all VMFeature values known to this library.
+func VMFeatureValues() []VMFeature {
+	return append([]VMFeature(nil), vmFeatureValues[:]...)
+}
+
+// knownValues returns the names of all known VMFeature values for the JSON schema.
+func (VMFeature) knownValues() []string {
+	values := make([]string, len(vmFeatureValues))
+	for i, v := range vmFeatureValues {
+		values[i] = string(v)
+	}
+	return values
 }
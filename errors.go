@@ -3,11 +3,14 @@ package goproxmox
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 var (
 	vmDoesNotExistRegexp   = regexp.MustCompile(`500 Configuration file \S+\/(\d+).conf' does not exist$`)
 	nodeDoesNotExistRegexp = regexp.MustCompile(`500 hostname lookup '(\S+)' failed - failed to get address info for: \S+: Name or service not known$`)
+	vmConfigConflictRegexp = regexp.MustCompile(`^\d+ (detected modified configuration - file changed by other user\?.*)$`)
+	vmAlreadyExistsRegexp  = regexp.MustCompile(`VM (\d+) already exists`)
 )
 
 // ArgError is an error that represents an error with an input to goproxmox. It
@@ -31,6 +34,27 @@ func (e *ArgError) Error() string {
 	return fmt.Sprintf("%s is invalid because %s", e.arg, e.reason)
 }
 
+// Arg returns the name of the invalid argument, e.g. "net0.tag".
+func (e *ArgError) Arg() string {
+	return e.arg
+}
+
+// Reason returns why the argument is invalid.
+func (e *ArgError) Reason() string {
+	return e.reason
+}
+
+// ValidationErrors is a list of all problems found by VMConfig.Validate.
+type ValidationErrors []*ArgError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
 type NodeDoesNotExistError struct {
 	Node string
 }
@@ -46,3 +70,64 @@ type VMDoesNotExistError struct {
 func (e *VMDoesNotExistError) Error() string {
 	return fmt.Sprintf("VM with id %s doesn't exist", e.VMID)
 }
+
+// VMAlreadyExistsError is returned when a VMID is already used by a guest somewhere in the cluster.
+type VMAlreadyExistsError struct {
+	VMID int
+}
+
+func (e *VMAlreadyExistsError) Error() string {
+	return fmt.Sprintf("VM with id %d already exists", e.VMID)
+}
+
+// VMConfigConflictError is returned when a VM config update was sent with a digest
+// that no longer matches the current configuration.
+type VMConfigConflictError struct {
+	Message string
+}
+
+func (e *VMConfigConflictError) Error() string {
+	return fmt.Sprintf("VM config was modified concurrently: %s", e.Message)
+}
+
+// AgentCommandError is returned when a command run via the guest agent on behalf of a helper failed.
+type AgentCommandError struct {
+	Command  []string
+	ExitCode int
+	Stderr   string
+}
+
+func (e *AgentCommandError) Error() string {
+	return fmt.Sprintf("guest command %s failed with exit code %d: %s", strings.Join(e.Command, " "), e.ExitCode, strings.TrimSpace(e.Stderr))
+}
+
+// FileChecksumError is returned when a file copied to or from a VM does not have the expected checksum.
+type FileChecksumError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e *FileChecksumError) Error() string {
+	return fmt.Sprintf("sha256 checksum of %s is %s, expected %s", e.Path, e.Actual, e.Expected)
+}
+
+// FeatureNotSupportedError is returned when the storages of a VM don't support an operation, e.g. a linked
+// clone on storage without clone support. Node is set if the feature is only missing on that node.
+type FeatureNotSupportedError struct {
+	VMID         int
+	Feature      VMFeature
+	SnapshotName string
+	Node         string
+}
+
+func (e *FeatureNotSupportedError) Error() string {
+	message := fmt.Sprintf("VM %d doesn't support %s", e.VMID, e.Feature)
+	if e.SnapshotName != "" {
+		message += fmt.Sprintf(" of snapshot %s", e.SnapshotName)
+	}
+	if e.Node != "" {
+		message += fmt.Sprintf(" on node %s", e.Node)
+	}
+	return message
+}
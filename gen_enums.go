@@ -0,0 +1,367 @@
+//go:build ignore
+// +build ignore
+
+// This program generates enums.go from the enum table below. Run it with
+//
+//	go generate
+//
+// from the package directory.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+	"text/template"
+)
+
+type enumValue struct {
+	Const string
+	Value string
+}
+
+type enum struct {
+	Name   string
+	Values []enumValue
+}
+
+var enums = []enum{
+	{"Bios", []enumValue{
+		{"BIOS_SeaBIOS", "seabios"},
+		{"BIOS_OVMF", "ovmf"},
+	}},
+	{"BootDevice", []enumValue{
+		{"BOOT_Floppy", "a"},
+		{"BOOT_HardDisk", "c"},
+		{"BOOT_CDROM", "d"},
+		{"BOOT_Network", "n"},
+	}},
+	{"CPUType", []enumValue{
+		{"CPU_486", "486"},
+		{"CPU_Broadwell", "Broadwell"},
+		{"CPU_Broadwell_IBRS", "Broadwell-IBRS"},
+		{"CPU_Broadwell_noTSX", "Broadwell-noTSX"},
+		{"CPU_Broadwell_noTSX_IBRS", "Broadwell-noTSX-IBRS"},
+		{"CPU_Cascadelake_Server", "Cascadelake-Server"},
+		{"CPU_Cascadelake_Server_noTSX", "Cascadelake-Server-noTSX"},
+		{"CPU_Conroe", "Conroe"},
+		{"CPU_Cooperlake", "Cooperlake"},
+		{"CPU_EPYC", "EPYC"},
+		{"CPU_EPYC_IBPB", "EPYC-IBPB"},
+		{"CPU_EPYC_Rome", "EPYC-Rome"},
+		{"CPU_EPYC_Milan", "EPYC-Milan"},
+		{"CPU_EPYC_Genoa", "EPYC-Genoa"},
+		{"CPU_Haswell", "Haswell"},
+		{"CPU_Haswell_IBRS", "Haswell-IBRS"},
+		{"CPU_Haswell_noTSX", "Haswell-noTSX"},
+		{"CPU_Haswell_noTSX_IBRS", "Haswell-noTSX-IBRS"},
+		{"CPU_Icelake_Server", "Icelake-Server"},
+		{"CPU_Icelake_Server_noTSX", "Icelake-Server-noTSX"},
+		{"CPU_IvyBridge", "IvyBridge"},
+		{"CPU_IvyBridge_IBRS", "IvyBridge-IBRS"},
+		{"CPU_KnightsMill", "KnightsMill"},
+		{"CPU_Nehalem", "Nehalem"},
+		{"CPU_Nehalem_IBRS", "Nehalem-IBRS"},
+		{"CPU_Opteron_G1", "Opteron_G1"},
+		{"CPU_Opteron_G2", "Opteron_G2"},
+		{"CPU_Opteron_G3", "Opteron_G3"},
+		{"CPU_Opteron_G4", "Opteron_G4"},
+		{"CPU_Opteron_G5", "Opteron_G5"},
+		{"CPU_Penryn", "Penryn"},
+		{"CPU_SandyBridge", "SandyBridge"},
+		{"CPU_SandyBridge_IBRS", "SandyBridge-IBRS"},
+		{"CPU_SapphireRapids", "SapphireRapids"},
+		{"CPU_Skylake_Client", "Skylake-Client"},
+		{"CPU_Skylake_Client_IBRS", "Skylake-Client-IBRS"},
+		{"CPU_Skylake_Client_noTSX_IBRS", "Skylake-Client-noTSX-IBRS"},
+		{"CPU_Skylake_Server", "Skylake-Server"},
+		{"CPU_Skylake_Server_IBRS", "Skylake-Server-IBRS"},
+		{"CPU_Skylake_Server_noTSX_IBRS", "Skylake-Server-noTSX-IBRS"},
+		{"CPU_Westmere", "Westmere"},
+		{"CPU_Westmere_IBRS", "Westmere-IBRS"},
+		{"CPU_Athlon", "athlon"},
+		{"CPU_Core2duo", "core2duo"},
+		{"CPU_CoreDuo", "coreduo"},
+		{"CPU_HOST", "host"},
+		{"CPU_KVM32", "kvm32"},
+		{"CPU_KVM64", "kvm64"},
+		{"CPU_MAX", "max"},
+		{"CPU_Pentium", "pentium"},
+		{"CPU_Pentium2", "pentium2"},
+		{"CPU_Pentium3", "pentium3"},
+		{"CPU_Phenom", "phenom"},
+		{"CPU_Qemu32", "qemu32"},
+		{"CPU_Qemu64", "qemu64"},
+		{"CPU_X86_64_v2", "x86-64-v2"},
+		{"CPU_X86_64_v2_AES", "x86-64-v2-AES"},
+		{"CPU_X86_64_v3", "x86-64-v3"},
+		{"CPU_X86_64_v4", "x86-64-v4"},
+	}},
+	{"HugePages", []enumValue{
+		{"HugePages_1024", "1024"},
+		{"HugePages_2", "2"},
+		{"HugePages_ANY", "any"},
+	}},
+	{"KeyboardLayout", []enumValue{
+		{"KeyboardLayout_DA", "da"},
+		{"KeyboardLayout_DE", "de"},
+		{"KeyboardLayout_DE_CH", "de-ch"},
+		{"KeyboardLayout_EN_GB", "en-gb"},
+		{"KeyboardLayout_EN_US", "en-us"},
+		{"KeyboardLayout_ES", "es"},
+		{"KeyboardLayout_FI", "fi"},
+		{"KeyboardLayout_FR", "fr"},
+		{"KeyboardLayout_FR_BE", "fr-be"},
+		{"KeyboardLayout_FR_CA", "fr-ca"},
+		{"KeyboardLayout_FR_CH", "fr-ch"},
+		{"KeyboardLayout_HU", "hu"},
+		{"KeyboardLayout_IS", "is"},
+		{"KeyboardLayout_IT", "it"},
+		{"KeyboardLayout_JA", "ja"},
+		{"KeyboardLayout_LT", "lt"},
+		{"KeyboardLayout_MK", "mk"},
+		{"KeyboardLayout_NL", "nl"},
+		{"KeyboardLayout_NO", "no"},
+		{"KeyboardLayout_PL", "pl"},
+		{"KeyboardLayout_PT", "pt"},
+		{"KeyboardLayout_PT_BR", "pt-br"},
+		{"KeyboardLayout_SL", "sl"},
+		{"KeyboardLayout_SV", "sv"},
+		{"KeyboardLayout_TR", "tr"},
+	}},
+	{"Lock", []enumValue{
+		{"Lock_Backup", "backup"},
+		{"Lock_Clone", "clone"},
+		{"Lock_Create", "create"},
+		{"Lock_Migrate", "migrate"},
+		{"Lock_Rollback", "rollback"},
+		{"Lock_Snapshot", "snapshot"},
+		{"Lock_SnapshotDelete", "snapshot-delete"},
+		{"Lock_Suspending", "suspending"},
+		{"Lock_Suspended", "suspended"},
+	}},
+	{"OSType", []enumValue{
+		{"OS_Unspecified", "other"},
+		{"OS_WindowsXP", "wxp"},
+		{"OS_Windows2000", "w2k"},
+		{"OS_Windows2003", "w2k3"},
+		{"OS_Windows2008", "w2k8"},
+		{"OS_WindowsVista", "wvista"},
+		{"OS_Windows7", "win7"},
+		{"OS_Windows8_2012", "win8"},
+		{"OS_Windows10_2016_2019", "win10"},
+		{"OS_Windows11_2022", "win11"},
+		{"OS_Linux24", "l24"},
+		{"OS_Linux26_3X", "l26"},
+		{"OS_Solaris", "solaris"},
+	}},
+	{"SCSIControllerType", []enumValue{
+		{"SCSI_LSI", "lsi"},
+		{"SCSI_LSI53C810", "lsi53c810"},
+		{"SCSI_VirtIO_SCSI_PCI", "virtio-scsi-pci"},
+		{"SCSI_VirtIO_SCSI_SINGLE", "virtio-scsi-single"},
+		{"SCSI_MEGASAS", "megasas"},
+		{"SCSI_PVSCSI", "pvscsi"},
+	}},
+	{"VGAType", []enumValue{
+		{"VGA_Cirrus", "cirrus"},
+		{"VGA_None", "none"},
+		{"VGA_QXL", "qxl"},
+		{"VGA_QXL2", "qxl2"},
+		{"VGA_QXL3", "qxl3"},
+		{"VGA_QXL4", "qxl4"},
+		{"VGA_Serial0", "serial0"},
+		{"VGA_Serial1", "serial1"},
+		{"VGA_Serial2", "serial2"},
+		{"VGA_Serial3", "serial3"},
+		{"VGA_std", "std"},
+		{"VGA_VirtIO", "virtio"},
+		{"VGA_VirtIO_GL", "virtio-gl"},
+		{"VGA_VMWare", "vmware"},
+	}},
+	{"NetworkCardModel", []enumValue{
+		{"NetworkCard_E1000", "e1000"},
+		{"NetworkCard_E1000_82540em", "e1000-82540em"},
+		{"NetworkCard_E1000_82544gc", "e1000-82544gc"},
+		{"NetworkCard_E1000_82545em", "e1000-82545em"},
+		{"NetworkCard_E1000E", "e1000e"},
+		{"NetworkCard_I82551", "i82551"},
+		{"NetworkCard_I82557b", "i82557b"},
+		{"NetworkCard_I82559er", "i82559er"},
+		{"NetworkCard_NE2K_ISA", "ne2k_isa"},
+		{"NetworkCard_NE2K_PCI", "ne2k_pci"},
+		{"NetworkCard_PCNET", "pcnet"},
+		{"NetworkCard_RTL8139", "rtl8139"},
+		{"NetworkCard_VIRTIO", "virtio"},
+		{"NetworkCard_VMXNET3", "vmxnet3"},
+	}},
+	{"VolumeFormat", []enumValue{
+		{"VolumeFormat_CLOOP", "cloop"},
+		{"VolumeFormat_COW", "cow"},
+		{"VolumeFormat_QCOW", "qcow"},
+		{"VolumeFormat_QCOW2", "qcow2"},
+		{"VolumeFormat_QED", "qed"},
+		{"VolumeFormat_RAW", "raw"},
+		{"VolumeFormat_VMDK", "vmdk"},
+	}},
+	{"MediaType", []enumValue{
+		{"MediaType_CDROM", "cdrom"},
+		{"MediaType_DISK", "disk"},
+	}},
+	{"EFIType", []enumValue{
+		{"EFIType_2M", "2m"},
+		{"EFIType_4M", "4m"},
+	}},
+	{"TPMVersion", []enumValue{
+		{"TPMVersion_1_2", "v1.2"},
+		{"TPMVersion_2_0", "v2.0"},
+	}},
+	{"VIOMMU", []enumValue{
+		{"VIOMMU_Intel", "intel"},
+		{"VIOMMU_VirtIO", "virtio"},
+	}},
+	{"RNGSource", []enumValue{
+		{"RNGSource_URandom", "/dev/urandom"},
+		{"RNGSource_Random", "/dev/random"},
+		{"RNGSource_HWRNG", "/dev/hwrng"},
+	}},
+	{"AudioDeviceModel", []enumValue{
+		{"AudioDevice_ICH9_Intel_HDA", "ich9-intel-hda"},
+		{"AudioDevice_Intel_HDA", "intel-hda"},
+		{"AudioDevice_AC97", "AC97"},
+	}},
+	{"AudioDriver", []enumValue{
+		{"AudioDriver_Spice", "spice"},
+		{"AudioDriver_None", "none"},
+	}},
+	{"VideoStreaming", []enumValue{
+		{"VideoStreaming_Off", "off"},
+		{"VideoStreaming_All", "all"},
+		{"VideoStreaming_Filter", "filter"},
+	}},
+	{"QemuAgentType", []enumValue{
+		{"QemuAgentType_VirtIO", "virtio"},
+		{"QemuAgentType_ISA", "isa"},
+	}},
+	{"CloudInitType", []enumValue{
+		{"CloudInit_NoCloud", "nocloud"},
+		{"CloudInit_ConfigDrive2", "configdrive2"},
+		{"CloudInit_OpenNebula", "opennebula"},
+	}},
+	{"RRDTimeframe", []enumValue{
+		{"RRDTimeframe_Hour", "hour"},
+		{"RRDTimeframe_Day", "day"},
+		{"RRDTimeframe_Week", "week"},
+		{"RRDTimeframe_Month", "month"},
+		{"RRDTimeframe_Year", "year"},
+		{"RRDTimeframe_Decade", "decade"},
+	}},
+	{"RRDConsolidation", []enumValue{
+		{"RRDConsolidation_Average", "AVERAGE"},
+		{"RRDConsolidation_Max", "MAX"},
+	}},
+	{"VMFeature", []enumValue{
+		{"VMFeature_Snapshot", "snapshot"},
+		{"VMFeature_Clone", "clone"},
+		{"VMFeature_Copy", "copy"},
+	}},
+}
+
+var enumsTemplate = template.Must(template.New("enums").Funcs(template.FuncMap{
+	"lowerFirst": lowerFirst,
+}).Parse(`// Code generated by gen_enums.go; DO NOT EDIT.
+
+package goproxmox
+
+import "fmt"
+{{range .}}{{$name := .Name}}{{$values := printf "%sValues" (lowerFirst .Name)}}
+// {{.Name}} is an open enum: values unknown to this library are kept verbatim.
+type {{.Name}} string
+
+const (
+{{- range .Values}}
+	{{.Const}} {{$name}} = {{printf "%q" .Value}}
+{{- end}}
+)
+
+var {{$values}} = [...]{{.Name}}{
+{{- range .Values}}
+	{{.Const}},
+{{- end}}
+}
+
+// String returns the name of the {{.Name}}.
+func (m {{.Name}}) String() string { return string(m) }
+
+// IsKnown reports whether m is one of the {{.Name}} values known to this library.
+func (m {{.Name}}) IsKnown() bool {
+	for _, v := range {{$values}} {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m {{.Name}}) MarshalText() ([]byte, error) { return []byte(m), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Unknown values are kept verbatim.
+func (m *{{.Name}}) UnmarshalText(text []byte) error {
+	*m = {{.Name}}(text)
+	return nil
+}
+
+// {{.Name}}FromString returns s as {{.Name}}. If s is not a known value it is still
+// returned verbatim, together with an error.
+func {{.Name}}FromString(s string) ({{.Name}}, error) {
+	m := {{.Name}}(s)
+	if !m.IsKnown() {
+		return m, fmt.Errorf("%s does not belong to {{.Name}} values", s)
+	}
+	return m, nil
+}
+
+// {{.Name}}Values returns all {{.Name}} values known to this library.
+func {{.Name}}Values() []{{.Name}} {
+	return append([]{{.Name}}(nil), {{$values}}[:]...)
+}
+
+// knownValues returns the names of all known {{.Name}} values for the JSON schema.
+func ({{.Name}}) knownValues() []string {
+	values := make([]string, len({{$values}}))
+	for i, v := range {{$values}} {
+		values[i] = string(v)
+	}
+	return values
+}
+{{end}}`))
+
+// lowerFirst lowercases the leading initialism or letter of an identifier,
+// e.g. "VGAType" -> "vgaType", "Bios" -> "bios".
+func lowerFirst(s string) string {
+	n := 0
+	for n < len(s) && 'A' <= s[n] && s[n] <= 'Z' {
+		n++
+	}
+	if n > 1 && n < len(s) {
+		n--
+	}
+	return strings.ToLower(s[:n]) + s[n:]
+}
+
+func main() {
+	var buf bytes.Buffer
+	if err := enumsTemplate.Execute(&buf, enums); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("enums.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
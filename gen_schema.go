@@ -0,0 +1,125 @@
+//go:build ignore
+// +build ignore
+
+// This program writes vmconfig.schema.json, the JSON Schema of VMConfig, with the doc comments
+// of the Go types as descriptions. Run it with
+//
+//	go generate
+//
+// from the package directory.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/andrexus/goproxmox"
+)
+
+// structField is a documented field of a struct type.
+type structField struct {
+	Doc      string
+	TypeName string
+}
+
+func main() {
+	docs := make(map[string]map[string]structField)
+	fset := token.NewFileSet()
+	for _, filename := range []string{"qm_config.go", "qm_options.go"} {
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		collectStructDocs(file, docs)
+	}
+
+	schema := goproxmox.VMConfigJSONSchema()
+	describe(schema, "VMConfig", docs)
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(schema); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("vmconfig.schema.json", buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// collectStructDocs stores the doc comment and type name of every field, keyed by struct and JSON name.
+func collectStructDocs(file *ast.File, docs map[string]map[string]structField) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		typeSpec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		fields := make(map[string]structField)
+		for _, field := range structType.Fields.List {
+			if field.Tag == nil || len(field.Names) == 0 {
+				continue
+			}
+			tag, _ := strconv.Unquote(field.Tag.Value)
+			name := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			fields[name] = structField{
+				Doc:      strings.Join(strings.Fields(field.Doc.Text()), " "),
+				TypeName: typeName(field.Type),
+			}
+		}
+		docs[typeSpec.Name.Name] = fields
+		return false
+	})
+}
+
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.MapType:
+		return typeName(t.Value)
+	case *ast.ArrayType:
+		return typeName(t.Elt)
+	}
+	return ""
+}
+
+// describe adds the field descriptions of the struct typeName to schema and its nested objects.
+func describe(schema map[string]interface{}, typeName string, docs map[string]map[string]structField) {
+	for name, field := range docs[typeName] {
+		var property map[string]interface{}
+		if strings.HasSuffix(name, "[n]") {
+			patternProperties, _ := schema["patternProperties"].(map[string]interface{})
+			property, _ = patternProperties["^"+strings.TrimSuffix(name, "[n]")+`\d+$`].(map[string]interface{})
+		} else {
+			properties, _ := schema["properties"].(map[string]interface{})
+			property, _ = properties[name].(map[string]interface{})
+		}
+		if property == nil {
+			continue
+		}
+		if field.Doc != "" {
+			property["description"] = field.Doc
+		}
+		if _, ok := docs[field.TypeName]; ok {
+			describe(property, field.TypeName, docs)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package goproxmox
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 
 	"context"
 
@@ -17,6 +19,9 @@ import (
 	"github.com/hashicorp/logutils"
 )
 
+//go:generate go run gen_enums.go
+//go:generate go run gen_schema.go
+
 const (
 	libraryVersion  = "0.1.7"
 	logLevelEnvName = "GOPROXMOX_LOGLEVEL"
@@ -43,6 +48,9 @@ type Client struct {
 	// HTTP client used to communicate with the proxmox API.
 	client *http.Client
 
+	// Source of the authentication ticket used by client, also needed for websocket connections
+	ticketSource pveauth.TicketSource
+
 	// Base URL for API requests.
 	BaseURL *url.URL
 
@@ -56,6 +64,10 @@ type Client struct {
 	Nodes    NodesService
 	VMs      QemuService
 	Storages StorageService
+	Cluster  ClusterService
+
+	// Typed access to the API endpoints generated from the API schema
+	API *API
 
 	// Optional function called after every successful request made to the proxmox API
 	onRequestCompleted RequestCompletionCallback
@@ -87,39 +99,54 @@ func NewClient(host, username, password string) *Client {
 	if err != nil {
 		panic(err)
 	}
-	httpClient := config.Client(context.Background(), ticket)
+	ticketSource := config.TicketSource(context.Background(), ticket)
+	httpClient := pveauth.NewClient(context.Background(), ticketSource)
 
 	apiServerBaseUrl := fmt.Sprintf("%s%s", host, apiBasePath)
 	baseURL, _ := url.Parse(apiServerBaseUrl)
 
-	c := &Client{client: httpClient, BaseURL: baseURL}
+	c := &Client{client: httpClient, BaseURL: baseURL, ticketSource: ticketSource}
 
 	log.Printf("[DEBUG] Base URL: %s\n", baseURL)
 
 	c.Nodes = &NodesServiceOp{client: c}
 	c.VMs = &QemuServiceOp{client: c}
 	c.Storages = &StorageServiceOp{client: c}
+	c.Cluster = &ClusterServiceOp{client: c}
+	c.API = &API{client: c}
 
 	return c
 }
 
+// Ticket returns the authentication ticket of the client. An expired ticket is renewed first.
+func (c *Client) Ticket() (*pveauth.Ticket, error) {
+	if c.ticketSource == nil {
+		return nil, errors.New("client has no ticket source")
+	}
+	return c.ticketSource.Ticket()
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
 // value pointed to by body is JSON encoded and included in as the request body.
 func (c *Client) NewRequest(method, urlStr string, body map[string]string) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
-	if err != nil {
-		return nil, err
-	}
-
-	u := c.BaseURL.ResolveReference(rel)
-
 	urlValues := url.Values{}
 	if body != nil {
 		for k, v := range body {
 			urlValues.Add(k, v)
 		}
 	}
+	return c.newFormRequest(method, urlStr, urlValues)
+}
+
+// newFormRequest is NewRequest for bodies with repeated values, as needed for list parameters.
+func (c *Client) newFormRequest(method, urlStr string, urlValues url.Values) (*http.Request, error) {
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.BaseURL.ResolveReference(rel)
 
 	req, err := http.NewRequest(method, u.String(), bytes.NewBufferString(urlValues.Encode()))
 	if err != nil {
@@ -144,7 +171,7 @@ func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	log.Printf("[DEBUG] Request: %s\n", req)
+	log.Printf("[DEBUG] Request: %s %s\n", req.Method, req.URL)
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -216,6 +243,18 @@ func CheckResponse(r *http.Response) error {
 	if len(matchResults) > 1 {
 		return &VMDoesNotExistError{matchResults[1]}
 	}
+	matchResults = vmConfigConflictRegexp.FindStringSubmatch(r.Status)
+	if len(matchResults) > 1 {
+		return &VMConfigConflictError{matchResults[1]}
+	}
+	// the conflict is either reported in the status line or as parameter error of the vmid
+	for _, message := range append([]string{r.Status}, errorResponse.Errors["vmid"]) {
+		matchResults = vmAlreadyExistsRegexp.FindStringSubmatch(message)
+		if len(matchResults) > 1 {
+			vmID, _ := strconv.Atoi(matchResults[1])
+			return &VMAlreadyExistsError{vmID}
+		}
+	}
 
 	return errorResponse
 }
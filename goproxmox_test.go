@@ -0,0 +1,22 @@
+package goproxmox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+)
+
+// setup starts a test server with handler as the API and returns a client for it and a function that stops
+// the server.
+func setup(handler http.Handler) (*Client, func()) {
+	server := httptest.NewServer(handler)
+	baseURL, _ := url.Parse(server.URL + apiBasePath)
+
+	c := &Client{client: http.DefaultClient, BaseURL: baseURL}
+	c.Nodes = &NodesServiceOp{client: c}
+	c.VMs = &QemuServiceOp{client: c}
+	c.Storages = &StorageServiceOp{client: c}
+	c.Cluster = &ClusterServiceOp{client: c}
+	c.API = &API{client: c}
+	return c, server.Close
+}
@@ -1,9 +1,20 @@
 package goproxmox
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
 
 type NodesService interface {
 	GetNodes() ([]Node, error)
+	GetNodeRRDData(ctx context.Context, node string, timeframe RRDTimeframe, cf RRDConsolidation) ([]NodeRRDPoint, error)
+	StartAll(ctx context.Context, node string, config *StartAllConfig) (*Task, error)
+	StopAll(ctx context.Context, node string, config *StopAllConfig) (*Task, error)
+	MigrateAll(ctx context.Context, node string, target string, config *MigrateAllConfig) (*Task, error)
+	EvacuateNode(ctx context.Context, node string, options *EvacuationOptions) (*EvacuationReport, error)
+	RestoreNode(ctx context.Context, evacuation *EvacuationReport, options *EvacuationOptions) (*EvacuationReport, error)
 }
 
 type NodesServiceOp struct {
@@ -26,6 +37,26 @@ type Node struct {
 	Level   string  `json:"level"`
 }
 
+// StartAllConfig holds the optional parameters of StartAll.
+type StartAllConfig struct {
+	VMIDs []int // Only start these guests. Defaults to all guests of the node
+	Force *bool // Also start guests that don't have onboot set
+}
+
+// StopAllConfig holds the optional parameters of StopAll.
+type StopAllConfig struct {
+	VMIDs     []int // Only stop these guests. Defaults to all guests of the node
+	Timeout   *int  // Seconds each guest gets to shut down, 0-7200. Defaults to 180
+	ForceStop *bool // Stop guests hard that didn't shut down within the timeout. Defaults to true
+}
+
+// MigrateAllConfig holds the optional parameters of MigrateAll.
+type MigrateAllConfig struct {
+	VMIDs          []int // Only migrate these guests. Defaults to all guests of the node
+	MaxWorkers     *int  // Number of parallel migrations. Defaults to max_workers of datacenter.cfg, one of both must be set
+	WithLocalDisks *bool // Migrate local disks along with running guests
+}
+
 type nodesRoot struct {
 	Nodes []Node `json:"data"`
 }
@@ -45,3 +76,65 @@ func (s *NodesServiceOp) GetNodes() ([]Node, error) {
 
 	return root.Nodes, err
 }
+
+// Start the guests of the node, by default those with onboot set, in the order of their startup settings.
+func (s *NodesServiceOp) StartAll(ctx context.Context, node string, config *StartAllConfig) (*Task, error) {
+	params := new(PostNodesStartAllParams)
+	if config != nil {
+		params.Vms = vmIDList(config.VMIDs)
+		params.Force = config.Force
+	}
+	upid, err := s.client.API.PostNodesStartAll(ctx, node, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// Shut down the guests of the node in the reverse order of their startup settings.
+func (s *NodesServiceOp) StopAll(ctx context.Context, node string, config *StopAllConfig) (*Task, error) {
+	params := new(PostNodesStopAllParams)
+	if config != nil {
+		params.Vms = vmIDList(config.VMIDs)
+		params.Timeout = config.Timeout
+		params.ForceStop = config.ForceStop
+	}
+	upid, err := s.client.API.PostNodesStopAll(ctx, node, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// Migrate the guests of the node to target, running guests online.
+func (s *NodesServiceOp) MigrateAll(ctx context.Context, node string, target string, config *MigrateAllConfig) (*Task, error) {
+	if target == "" {
+		return nil, NewArgError("target", "cannot be empty")
+	}
+	if target == node {
+		return nil, NewArgError("target", "must differ from the source node")
+	}
+	params := new(PostNodesMigrateAllParams)
+	if config != nil {
+		params.Vms = vmIDList(config.VMIDs)
+		params.MaxWorkers = config.MaxWorkers
+		params.WithLocalDisks = config.WithLocalDisks
+	}
+	upid, err := s.client.API.PostNodesMigrateAll(ctx, node, target, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// vmIDList formats VMIDs as the comma separated list PVE expects, nil for none.
+func vmIDList(vmIDs []int) *string {
+	if len(vmIDs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(vmIDs))
+	for i, vmID := range vmIDs {
+		ids[i] = strconv.Itoa(vmID)
+	}
+	return String(strings.Join(ids, ","))
+}
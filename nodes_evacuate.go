@@ -0,0 +1,534 @@
+package goproxmox
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Default number of VMs EvacuateNode and RestoreNode migrate at once.
+const defaultEvacuationParallelism = 2
+
+var (
+	hostPCIRegexp  = regexp.MustCompile(`^hostpci\d*$`)
+	serialRegexp   = regexp.MustCompile(`^serial\d+$`)
+	parallelRegexp = regexp.MustCompile(`^parallel\d+$`)
+	unusedRegexp   = regexp.MustCompile(`^unused\d+$`)
+
+	// cloud-init drives like local-lvm:vm-100-cloudinit or local:100/vm-100-cloudinit.qcow2
+	cloudInitVolumeRegexp = regexp.MustCompile(`^[^:]+:(\d+/)?vm-\d+-cloudinit(\.\w+)?$`)
+)
+
+// EvacuationAction is what EvacuateNode or RestoreNode did with a VM.
+type EvacuationAction string
+
+const (
+	EvacuationActionMigratedOnline  EvacuationAction = "migrated-online"  // the running VM was migrated live
+	EvacuationActionMigratedOffline EvacuationAction = "migrated-offline" // the stopped VM was migrated
+	EvacuationActionHAMigrated      EvacuationAction = "ha-migrated"      // the HA manager migrated the VM
+	EvacuationActionSkipped         EvacuationAction = "skipped"          // the VM stayed where it was, see Reason
+)
+
+// EvacuationOptions holds the optional parameters of EvacuateNode and RestoreNode.
+type EvacuationOptions struct {
+	// Only move these VMs. Defaults to all VMs of the node
+	VMIDs []int
+
+	// Nodes the VMs may be moved to. Defaults to all other online nodes
+	TargetNodes []string
+
+	// Copy disks on local storages along. Without it VMs with local disks are skipped
+	WithLocalDisks bool
+
+	// Number of VMs migrated at once. Defaults to 2
+	Parallelism int
+
+	// Enables or disables the HA maintenance mode of node. PVE offers it only with "ha-manager crm-command
+	// node-maintenance" on a cluster node, not via the API, so it's left to the caller, e.g. to run the
+	// command over SSH. In maintenance mode the HA manager moves the HA managed VMs itself, and back once
+	// the mode is disabled; without it EvacuateNode requests the migration of HA managed VMs from the HA
+	// manager
+	SetMaintenance func(ctx context.Context, node string, enable bool) error
+
+	// Polling of the migrations. The timeout applies to each VM
+	Wait *WaitConfig
+}
+
+func (o *EvacuationOptions) parallelism() int {
+	if o == nil || o.Parallelism <= 0 {
+		return defaultEvacuationParallelism
+	}
+	return o.Parallelism
+}
+
+func (o *EvacuationOptions) wait() *WaitConfig {
+	if o == nil {
+		return nil
+	}
+	return o.Wait
+}
+
+// EvacuationReport is the outcome of EvacuateNode or RestoreNode.
+type EvacuationReport struct {
+	Node        string // Node that was evacuated or restored
+	Maintenance bool   // Set if EvacuateNode enabled the HA maintenance mode of Node
+	Started     time.Time
+	Finished    time.Time
+	VMs         []EvacuatedVM
+}
+
+// EvacuatedVM is what happened to a VM during EvacuateNode or RestoreNode.
+type EvacuatedVM struct {
+	VMID       int
+	Name       string
+	From       string
+	To         string // Empty if the VM was skipped
+	Action     EvacuationAction
+	Reason     string   // Why the VM was skipped
+	LocalDisks []string // Volumes on local storages copied along
+	Err        error    // Set if the migration failed
+	Duration   time.Duration
+}
+
+// Failed returns the VMs whose migration failed.
+func (r *EvacuationReport) Failed() []EvacuatedVM {
+	var failed []EvacuatedVM
+	for _, vm := range r.VMs {
+		if vm.Err != nil {
+			failed = append(failed, vm)
+		}
+	}
+	return failed
+}
+
+// Skipped returns the VMs that were not moved.
+func (r *EvacuationReport) Skipped() []EvacuatedVM {
+	var skipped []EvacuatedVM
+	for _, vm := range r.VMs {
+		if vm.Action == EvacuationActionSkipped {
+			skipped = append(skipped, vm)
+		}
+	}
+	return skipped
+}
+
+// vmMove is a planned migration of a VM.
+type vmMove struct {
+	vm      *EvacuatedVM
+	running bool
+
+	// migrate via the HA manager; waitOnly if the HA manager moves the VM on its own
+	ha       bool
+	waitOnly bool
+}
+
+// evacuationTarget is a node VMs can be moved to.
+type evacuationTarget struct {
+	node     string
+	mem      int64 // including the memory of the VMs planned to move there
+	maxMem   int64
+	storages map[string]bool
+}
+
+func (t *evacuationTarget) load() float64 {
+	if t.maxMem == 0 {
+		return 1
+	}
+	return float64(t.mem) / float64(t.maxMem)
+}
+
+// Move the VMs off the node for maintenance: enable the HA maintenance mode if options.SetMaintenance is
+// set, migrate each VM to the least loaded node that can take it, running VMs live and stopped VMs offline,
+// and wait for the migrations. VMs with passthrough devices, ISO images on local storages, locked VMs and,
+// without options.WithLocalDisks, VMs with disks on local storages stay and are reported as skipped. Failed
+// migrations are reported as well; the error is only set if the evacuation could not be planned.
+func (s *NodesServiceOp) EvacuateNode(ctx context.Context, node string, options *EvacuationOptions) (*EvacuationReport, error) {
+	if node == "" {
+		return nil, NewArgError("node", "cannot be empty")
+	}
+	report := &EvacuationReport{Node: node, Started: time.Now()}
+	if options != nil && options.SetMaintenance != nil {
+		if err := options.SetMaintenance(ctx, node, true); err != nil {
+			return nil, err
+		}
+		report.Maintenance = true
+	}
+
+	moves, err := s.planEvacuation(ctx, report, options)
+	if err != nil {
+		return report, err
+	}
+	s.executeMoves(ctx, moves, options)
+	report.Finished = time.Now()
+	return report, nil
+}
+
+func (s *NodesServiceOp) planEvacuation(ctx context.Context, report *EvacuationReport, options *EvacuationOptions) ([]*vmMove, error) {
+	node := report.Node
+	vms, err := s.client.VMs.GetVMList(node)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].VMID < vms[j].VMID })
+	if options != nil && len(options.VMIDs) > 0 {
+		vms = filterVMs(vms, options.VMIDs)
+	}
+
+	haType := GetClusterHAResourcesType_VM
+	haResources, err := s.client.API.GetClusterHAResources(ctx, &GetClusterHAResourcesParams{Type: &haType})
+	if err != nil {
+		return nil, err
+	}
+	haManaged := make(map[string]bool)
+	for _, resource := range haResources {
+		haManaged[resource.SID] = true
+	}
+
+	sharedStorages, err := s.storages(ctx, node, func(storage GetNodesStorageItem) bool { return bool(storage.Shared) })
+	if err != nil {
+		return nil, err
+	}
+	targets, err := s.evacuationTargets(ctx, node, options)
+	if err != nil {
+		return nil, err
+	}
+
+	report.VMs = make([]EvacuatedVM, len(vms))
+	var moves []*vmMove
+	for i, vm := range vms {
+		entry := &report.VMs[i]
+		*entry = EvacuatedVM{VMID: vm.VMID, Name: vm.Name, From: node}
+		move := &vmMove{vm: entry, running: vm.Status == "running", ha: haManaged[haSID(vm.VMID)]}
+		if move.ha && report.Maintenance {
+			move.waitOnly = true
+			moves = append(moves, move)
+			continue
+		}
+		if vm.Lock != "" {
+			entry.skip("locked (%s)", vm.Lock)
+			continue
+		}
+
+		config, err := s.client.VMs.GetVMConfig(node, vm.VMID)
+		if err != nil {
+			return nil, err
+		}
+		resources, err := getVMLocalResources(config, sharedStorages)
+		if err != nil {
+			return nil, err
+		}
+		localDisks := resources.volumes
+		switch {
+		case len(resources.devices) > 0:
+			entry.skip("uses local devices %s", strings.Join(resources.devices, ", "))
+			continue
+		case len(resources.isos) > 0:
+			entry.skip("has local ISO images in %s, eject them or use a shared storage", strings.Join(resources.isos, ", "))
+			continue
+		case len(localDisks) > 0 && move.ha:
+			entry.skip("HA managed with local disks %s", strings.Join(localDisks, ", "))
+			continue
+		case len(localDisks) > 0 && (options == nil || !options.WithLocalDisks):
+			entry.skip("has local disks %s", strings.Join(localDisks, ", "))
+			continue
+		}
+
+		target := leastLoadedTarget(targets, vm, localDisks)
+		if target == nil {
+			entry.skip("no node can take it")
+			continue
+		}
+		target.mem += int64(vm.MaxMemory)
+		entry.To = target.node
+		entry.LocalDisks = localDisks
+		moves = append(moves, move)
+	}
+	return moves, nil
+}
+
+// evacuationTargets returns the online nodes the VMs of node can be moved to.
+func (s *NodesServiceOp) evacuationTargets(ctx context.Context, node string, options *EvacuationOptions) ([]*evacuationTarget, error) {
+	nodes, err := s.client.API.GetNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool)
+	if options != nil {
+		for _, target := range options.TargetNodes {
+			allowed[target] = true
+		}
+	}
+
+	var targets []*evacuationTarget
+	for _, candidate := range nodes {
+		if candidate.Node == node || candidate.Status != "online" || (len(allowed) > 0 && !allowed[candidate.Node]) {
+			continue
+		}
+		storages, err := s.storages(ctx, candidate.Node, func(storage GetNodesStorageItem) bool {
+			return bool(storage.Active) && bool(storage.Enabled)
+		})
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, &evacuationTarget{
+			node:     candidate.Node,
+			mem:      candidate.Mem,
+			maxMem:   candidate.MaxMem,
+			storages: storages,
+		})
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].node < targets[j].node })
+	return targets, nil
+}
+
+// storages returns the names of the storages of node that match.
+func (s *NodesServiceOp) storages(ctx context.Context, node string, match func(GetNodesStorageItem) bool) (map[string]bool, error) {
+	storages, err := s.client.API.GetNodesStorage(ctx, node, nil)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, storage := range storages {
+		if match(storage) {
+			names[storage.Storage] = true
+		}
+	}
+	return names, nil
+}
+
+// leastLoadedTarget returns the target with the lowest memory usage that has the storages of localDisks and,
+// for a running VM, the memory to run it.
+func leastLoadedTarget(targets []*evacuationTarget, vm VM, localDisks []string) *evacuationTarget {
+	var best *evacuationTarget
+	for _, target := range targets {
+		if vm.Status == "running" && target.maxMem-target.mem < int64(vm.MaxMemory) {
+			continue
+		}
+		missingStorage := false
+		for _, volume := range localDisks {
+			if !target.storages[volumeStorage(volume)] {
+				missingStorage = true
+			}
+		}
+		if missingStorage {
+			continue
+		}
+		if best == nil || target.load() < best.load() {
+			best = target
+		}
+	}
+	return best
+}
+
+// Move the VMs EvacuateNode moved back to the evacuated node: disable the HA maintenance mode if
+// EvacuateNode enabled it, which lets the HA manager move its VMs back, and migrate the other VMs back.
+// VMs that were skipped, failed to migrate or were moved elsewhere since are skipped.
+func (s *NodesServiceOp) RestoreNode(ctx context.Context, evacuation *EvacuationReport, options *EvacuationOptions) (*EvacuationReport, error) {
+	if evacuation == nil {
+		return nil, NewArgError("evacuation", "cannot be nil")
+	}
+	node := evacuation.Node
+	if evacuation.Maintenance {
+		if options == nil || options.SetMaintenance == nil {
+			return nil, NewArgError("options.SetMaintenance", "is required to disable the maintenance mode of "+node)
+		}
+		if err := options.SetMaintenance(ctx, node, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// with the capacity preallocated the moves keep pointing into report.VMs
+	report := &EvacuationReport{Node: node, Started: time.Now(), VMs: make([]EvacuatedVM, 0, len(evacuation.VMs))}
+	s.client.Cluster.InvalidateResourceCache()
+	var moves []*vmMove
+	for _, evacuated := range evacuation.VMs {
+		if evacuated.Action == EvacuationActionSkipped || evacuated.Err != nil {
+			continue
+		}
+		report.VMs = append(report.VMs, EvacuatedVM{VMID: evacuated.VMID, Name: evacuated.Name, To: node})
+		entry := &report.VMs[len(report.VMs)-1]
+		location, err := s.client.Cluster.FindVM(ctx, evacuated.VMID)
+		if _, ok := err.(*VMDoesNotExistError); ok {
+			entry.To = ""
+			entry.skip("no longer exists")
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		entry.From = location.Node
+
+		ha := evacuated.Action == EvacuationActionHAMigrated
+		switch {
+		case ha && evacuation.Maintenance:
+			// the HA manager moves it back on its own, possibly has already
+		case location.Node == node:
+			entry.To = ""
+			entry.skip("already on %s", node)
+			continue
+		case location.Node != evacuated.To:
+			entry.To = ""
+			entry.skip("moved to %s since the evacuation", location.Node)
+			continue
+		}
+		entry.LocalDisks = evacuated.LocalDisks
+		moves = append(moves, &vmMove{
+			vm:       entry,
+			running:  location.Status == "running",
+			ha:       ha,
+			waitOnly: ha && evacuation.Maintenance,
+		})
+	}
+
+	s.executeMoves(ctx, moves, options)
+	report.Finished = time.Now()
+	return report, nil
+}
+
+// executeMoves migrates the VMs and records the outcome in their report entries.
+func (s *NodesServiceOp) executeMoves(ctx context.Context, moves []*vmMove, options *EvacuationOptions) {
+	runParallel(len(moves), options.parallelism(), func(i int) {
+		move := moves[i]
+		start := time.Now()
+		move.vm.Err = s.executeMove(ctx, move, options)
+		move.vm.Duration = time.Since(start)
+	})
+}
+
+func (s *NodesServiceOp) executeMove(ctx context.Context, move *vmMove, options *EvacuationOptions) error {
+	vm := move.vm
+	switch {
+	case move.waitOnly:
+		vm.Action = EvacuationActionHAMigrated
+		to, err := s.waitForVMNode(ctx, vm.VMID, func(node string) bool {
+			if vm.To != "" {
+				return node == vm.To
+			}
+			return node != vm.From
+		}, options.wait())
+		vm.To = to
+		return err
+	case move.ha:
+		vm.Action = EvacuationActionHAMigrated
+		if err := s.client.API.PostClusterHAResourcesMigrate(ctx, haSID(vm.VMID), vm.To); err != nil {
+			return err
+		}
+		_, err := s.waitForVMNode(ctx, vm.VMID, func(node string) bool { return node == vm.To }, options.wait())
+		return err
+	}
+
+	vm.Action = EvacuationActionMigratedOffline
+	if move.running {
+		vm.Action = EvacuationActionMigratedOnline
+	}
+	config := &VMMigrateConfig{Online: Bool(move.running)}
+	if len(vm.LocalDisks) > 0 {
+		config.WithLocalDisks = Bool(true)
+	}
+	task, err := s.client.VMs.MigrateVM(ctx, vm.From, vm.VMID, vm.To, config)
+	if err != nil {
+		return err
+	}
+	_, err = task.Wait(ctx, options.wait())
+	return err
+}
+
+// waitForVMNode polls the cluster resources until the VM is on a node that satisfies onNode and returns
+// that node.
+func (s *NodesServiceOp) waitForVMNode(ctx context.Context, vmID int, onNode func(node string) bool, config *WaitConfig) (string, error) {
+	node := ""
+	err := poll(ctx, config, func() (bool, error) {
+		s.client.Cluster.InvalidateResourceCache()
+		location, err := s.client.Cluster.FindVM(ctx, vmID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			return false, err
+		}
+		node = location.Node
+		return onNode(node), nil
+	})
+	if err == errPollTimeout {
+		return node, &WaitTimeoutError{VMID: vmID, Timeout: config.withDefaults().Timeout}
+	}
+	return node, err
+}
+
+func (vm *EvacuatedVM) skip(format string, args ...interface{}) {
+	vm.Action = EvacuationActionSkipped
+	vm.Reason = fmt.Sprintf(format, args...)
+}
+
+// vmLocalResources is what ties a VM to its node.
+type vmLocalResources struct {
+	volumes []string // volumes on storages that are not shared
+	isos    []string // keys of CD-ROM drives with an ISO image on a storage that is not shared
+	devices []string // keys of devices of the host like hostpci0
+}
+
+// getVMLocalResources returns the local resources of the VM. A cloud-init drive on a local storage is a
+// volume, even though it is attached as CD-ROM.
+func getVMLocalResources(config *VMConfig, sharedStorages map[string]bool) (*vmLocalResources, error) {
+	options, err := config.GetOptionsMap()
+	if err != nil {
+		return nil, err
+	}
+	addUnknownOptions(options, config)
+
+	resources := &vmLocalResources{}
+	for _, key := range sortedKeys(options) {
+		value := options[key]
+		switch {
+		case diskParameterRegexp.MatchString(key) || unusedRegexp.MatchString(key):
+			drive := parseQMOptionValue(value, "file")
+			file := drive["file"]
+			storage := volumeStorage(file)
+			switch {
+			case file == "" || file == "none" || sharedStorages[storage]:
+			case cloudInitVolumeRegexp.MatchString(file):
+				resources.volumes = append(resources.volumes, file)
+			case storage == "":
+				// a device or path of the host
+				resources.devices = append(resources.devices, key)
+			case drive["media"] == "cdrom":
+				// an ISO image is not copied along
+				resources.isos = append(resources.isos, key)
+			default:
+				resources.volumes = append(resources.volumes, file)
+			}
+		case hostPCIRegexp.MatchString(key) || parallelRegexp.MatchString(key):
+			resources.devices = append(resources.devices, key)
+		case usbRegexp.MatchString(key):
+			if host := parseQMOptionValue(value, "host")["host"]; host != "" && host != "spice" {
+				resources.devices = append(resources.devices, key)
+			}
+		case serialRegexp.MatchString(key):
+			if strings.HasPrefix(value, "/dev/") {
+				resources.devices = append(resources.devices, key)
+			}
+		}
+	}
+	return resources, nil
+}
+
+func filterVMs(vms []VM, vmIDs []int) []VM {
+	wanted := make(map[int]bool)
+	for _, vmID := range vmIDs {
+		wanted[vmID] = true
+	}
+	var filtered []VM
+	for _, vm := range vms {
+		if wanted[vm.VMID] {
+			filtered = append(filtered, vm)
+		}
+	}
+	return filtered
+}
+
+// haSID returns the ID of the HA resource of a VM.
+func haSID(vmID int) string {
+	return fmt.Sprintf("vm:%d", vmID)
+}
@@ -1,27 +1,69 @@
 package goproxmox
 
 import (
-	"errors"
+	"context"
 	"fmt"
-	"net/http"
-	"strconv"
+	"io"
+	"log"
+	"strings"
+	"time"
 )
 
 type QemuService interface {
 	GetVMList(node string) ([]VM, error)
 	GetVMCurrentStatus(node string, vmID int) (*VMStatus, error)
-	StartVM(node string, vmID int) error
-	StopVM(node string, vmID int) error
-	ShutdownVM(node string, vmID int) error
+	StartVM(ctx context.Context, node string, vmID int, config *VMStartConfig) error
+	StopVM(ctx context.Context, node string, vmID int, config *VMStopConfig) error
+	ShutdownVM(ctx context.Context, node string, vmID int, config *VMShutdownConfig) error
+	RebootVM(ctx context.Context, node string, vmID int, config *VMRebootConfig) error
+	EnsureStopped(ctx context.Context, node string, vmID int, grace time.Duration) (VMStopOutcome, error)
+	WaitForVMStatus(ctx context.Context, node string, vmID int, predicate VMStatusPredicate, config *WaitConfig) (*VMStatus, error)
+	PingAgent(ctx context.Context, node string, vmID int) error
+	SendKey(ctx context.Context, node string, vmID int, key string) error
+	TypeText(ctx context.Context, node string, vmID int, text string, config *TypeTextConfig) error
+	GetVMRRDData(ctx context.Context, node string, vmID int, timeframe RRDTimeframe, cf RRDConsolidation) ([]VMRRDPoint, error)
+	ExecAgentCommand(ctx context.Context, node string, vmID int, command []string, input string) (int, error)
+	GetAgentCommandStatus(ctx context.Context, node string, vmID int, pid int) (*AgentCommandStatus, error)
+	RunAgentCommand(ctx context.Context, node string, vmID int, command []string, input string) (*AgentCommandStatus, error)
+	ReadGuestFile(ctx context.Context, node string, vmID int, path string) ([]byte, bool, error)
+	WriteGuestFile(ctx context.Context, node string, vmID int, path string, content []byte) error
+	PushFile(ctx context.Context, node string, vmID int, r io.Reader, guestPath string, config *FileTransferConfig) error
+	PullFile(ctx context.Context, node string, vmID int, guestPath string, w io.Writer, config *FileTransferConfig) error
 	ResetVM(node string, vmID int) error
 	SuspendVM(node string, vmID int) error
 	ResumeVM(node string, vmID int) error
 	GetVMConfig(node string, vmID int) (*VMConfig, error)
+	GetVMPendingConfig(node string, vmID int) (VMPendingConfig, error)
 	CreateVM(node string, vmID int, config *VMConfig) error
+	CreateVMAuto(ctx context.Context, node string, config *VMConfig, vmIDRange *VMIDRange) (int, error)
 	UpdateVM(node string, vmID int, config *VMConfig, async bool) error
+	UpdateVMWithRetry(ctx context.Context, node string, vmID int, mutate func(*VMConfig) error) error
+	ApplyVMConfigDiff(ctx context.Context, node string, vmID int, diff *VMConfigDiff, config *VMConfigDiffApplyConfig) error
+	ResizeVMDisk(ctx context.Context, node string, vmID int, disk string, size string) (*Task, error)
+	MoveVMDisk(ctx context.Context, node string, vmID int, disk string, storage string, deleteSource bool) (*Task, error)
 	DeleteVM(node string, vmID int) error
 	CreateVMTemplate(node string, vmID int, disk string) error
 	CloneVM(node string, vmID int, newID int, config *VMCloneConfig) error
+	CloneBatch(ctx context.Context, templateID int, count int, options *CloneBatchOptions) (CloneResults, error)
+	GetVMFeature(ctx context.Context, node string, vmID int, feature VMFeature, snapshotName string) (*VMFeatureStatus, error)
+	CreateVMSnapshot(ctx context.Context, node string, vmID int, name string, config *VMSnapshotConfig) (*Task, error)
+	MigrateVM(ctx context.Context, node string, vmID int, target string, config *VMMigrateConfig) (*Task, error)
+	GetVMCurrentStatusByID(ctx context.Context, vmID int) (*VMStatus, error)
+	GetVMConfigByID(ctx context.Context, vmID int) (*VMConfig, error)
+	StartVMByID(ctx context.Context, vmID int, config *VMStartConfig) error
+	StopVMByID(ctx context.Context, vmID int, config *VMStopConfig) error
+	ShutdownVMByID(ctx context.Context, vmID int, config *VMShutdownConfig) error
+	ResetVMByID(ctx context.Context, vmID int) error
+	SuspendVMByID(ctx context.Context, vmID int) error
+	ResumeVMByID(ctx context.Context, vmID int) error
+	DeleteVMByID(ctx context.Context, vmID int) error
+	VNCProxy(ctx context.Context, node string, vmID int, config *VNCProxyConfig) (*VNCProxyTicket, error)
+	TermProxy(ctx context.Context, node string, vmID int, config *TermProxyConfig) (*TermProxyTicket, error)
+	SpiceProxy(ctx context.Context, node string, vmID int, proxy string) (SpiceProxyTicket, error)
+	GenerateSpiceConfig(ctx context.Context, node string, vmID int, proxy string, options *SpiceConfigOptions) ([]byte, error)
+	OpenVNCWebSocket(ctx context.Context, node string, vmID int, ticket *VNCProxyTicket) (io.ReadWriteCloser, error)
+	OpenTermWebSocket(ctx context.Context, node string, vmID int, ticket *TermProxyTicket) (*TermConn, error)
+	ForEachVM(ctx context.Context, node string, fn VMFunc, config *ForEachVMConfig) (VMResults, error)
 }
 
 type QemuServiceOp struct {
@@ -30,6 +72,9 @@ type QemuServiceOp struct {
 
 var _ QemuService = &QemuServiceOp{}
 
+// Number of VMIDs CreateVMAuto tries before it gives up.
+const createVMAutoAttempts = 10
+
 type responseRoot struct {
 	Data map[string]interface{} `json:"data"`
 }
@@ -42,45 +87,289 @@ type vmStatusRoot struct {
 	VMStatus VMStatus `json:"data"`
 }
 
+type vmPendingConfigRoot struct {
+	Items VMPendingConfig `json:"data"`
+}
+
 type VM struct {
-	VMID      int         `json:"vmid"`
-	Name      string      `json:"name"`
-	Status    string      `json:"status"`
-	PID       string      `json:"pid"`
-	Template  interface{} `json:"template"`
-	CPU       float64     `json:"cpu"`
-	CPUs      int         `json:"cpus"`
-	Memory    int         `json:"mem"`
-	MaxMemory int         `json:"maxmem"`
-	Disk      int         `json:"disk"`
-	DiskRead  int         `json:"diskread"`
-	DiskWrite int         `json:"diskwrite"`
-	MaxDisk   int         `json:"maxdisk"`
-	NetIn     int         `json:"netin"`
-	NetOut    int         `json:"netout"`
-	Uptime    int         `json:"uptime"`
+	VMID           int     `json:"vmid"`
+	Name           string  `json:"name"`
+	Status         string  `json:"status"`
+	QMPstatus      string  `json:"qmpstatus"` // only with the full status of running VMs
+	PID            int     `json:"pid"`
+	Lock           string  `json:"lock"`
+	Tags           string  `json:"tags"`
+	Template       IntBool `json:"template"`
+	CPU            float64 `json:"cpu"`
+	CPUs           float64 `json:"cpus"`
+	Memory         uint64  `json:"mem"`
+	MaxMemory      uint64  `json:"maxmem"`
+	Disk           uint64  `json:"disk"`
+	DiskRead       uint64  `json:"diskread"`
+	DiskWrite      uint64  `json:"diskwrite"`
+	MaxDisk        uint64  `json:"maxdisk"`
+	NetIn          uint64  `json:"netin"`
+	NetOut         uint64  `json:"netout"`
+	Uptime         uint64  `json:"uptime"`
+	RunningMachine string  `json:"running-machine"`
+	RunningQEMU    string  `json:"running-qemu"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Numbers sent as strings are accepted.
+func (vm *VM) UnmarshalJSON(data []byte) error {
+	type vmJSON VM
+	return unmarshalLenientNumbers(data, (*vmJSON)(vm))
+}
+
+// TagList returns the tags of the VM.
+func (vm *VM) TagList() []string {
+	return parseTags(vm.Tags)
 }
 
 type VMStatus struct {
-	Name      string      `json:"name"`
-	Status    string      `json:"status"`
-	QMPstatus string      `json:"qmpstatus"`
-	PID       string      `json:"pid"`
-	Template  interface{} `json:"template"`
-	CPU       float64     `json:"cpu"`
-	CPUs      int         `json:"cpus"`
-	Memory    int         `json:"mem"`
-	MaxMemory int         `json:"maxmem"`
-	Disk      int         `json:"disk"`
-	DiskRead  int         `json:"diskread"`
-	DiskWrite int         `json:"diskwrite"`
-	MaxDisk   int         `json:"maxdisk"`
-	NetIn     int         `json:"netin"`
-	NetOut    int         `json:"netout"`
-	Uptime    int         `json:"uptime"`
-	HA        interface{} `json:"ha"`
+	VMID           int                    `json:"vmid"`
+	Name           string                 `json:"name"`
+	Status         string                 `json:"status"`
+	QMPstatus      string                 `json:"qmpstatus"`
+	PID            int                    `json:"pid"`
+	Lock           string                 `json:"lock"`
+	Tags           string                 `json:"tags"`
+	Template       IntBool                `json:"template"`
+	Agent          IntBool                `json:"agent"`  // the QEMU guest agent is enabled in the config
+	Spice          IntBool                `json:"spice"`  // the VGA configuration supports spice
+	Serial         IntBool                `json:"serial"` // a serial console is configured
+	CPU            float64                `json:"cpu"`
+	CPUs           float64                `json:"cpus"`
+	Memory         uint64                 `json:"mem"`
+	MaxMemory      uint64                 `json:"maxmem"`
+	FreeMemory     uint64                 `json:"freemem"`
+	Balloon        uint64                 `json:"balloon"`
+	BalloonInfo    *VMBalloonInfo         `json:"ballooninfo"`
+	Disk           uint64                 `json:"disk"`
+	DiskRead       uint64                 `json:"diskread"`
+	DiskWrite      uint64                 `json:"diskwrite"`
+	MaxDisk        uint64                 `json:"maxdisk"`
+	NetIn          uint64                 `json:"netin"`
+	NetOut         uint64                 `json:"netout"`
+	Uptime         uint64                 `json:"uptime"`
+	BlockStat      map[string]VMBlockStat `json:"blockstat"` // keyed by drive, e.g. "scsi0"
+	NICs           map[string]VMNICStat   `json:"nics"`      // keyed by tap device, e.g. "tap100i0"
+	HA             VMHAStatus             `json:"ha"`
+	ProxmoxSupport VMProxmoxSupport       `json:"proxmox-support"`
+	RunningMachine string                 `json:"running-machine"`
+	RunningQEMU    string                 `json:"running-qemu"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Numbers sent as strings are accepted.
+func (s *VMStatus) UnmarshalJSON(data []byte) error {
+	type vmStatus VMStatus
+	return unmarshalLenientNumbers(data, (*vmStatus)(s))
+}
+
+// TagList returns the tags of the VM.
+func (s *VMStatus) TagList() []string {
+	return parseTags(s.Tags)
+}
+
+// VMBalloonInfo is the memory balloon statistics of a running VM.
+// The guest statistics are only set if the balloon driver of the guest reports them.
+type VMBalloonInfo struct {
+	Actual          uint64 `json:"actual"`
+	MaxMemory       uint64 `json:"max_mem"`
+	TotalMemory     uint64 `json:"total_mem"`
+	FreeMemory      uint64 `json:"free_mem"`
+	MemSwappedIn    uint64 `json:"mem_swapped_in"`
+	MemSwappedOut   uint64 `json:"mem_swapped_out"`
+	MajorPageFaults uint64 `json:"major_page_faults"`
+	MinorPageFaults uint64 `json:"minor_page_faults"`
+	LastUpdate      int64  `json:"last_update"`
+}
+
+// VMBlockStat is the I/O statistics of one drive of a running VM.
+type VMBlockStat struct {
+	ReadBytes              uint64  `json:"rd_bytes"`
+	WriteBytes             uint64  `json:"wr_bytes"`
+	UnmapBytes             uint64  `json:"unmap_bytes"`
+	ReadOperations         uint64  `json:"rd_operations"`
+	WriteOperations        uint64  `json:"wr_operations"`
+	FlushOperations        uint64  `json:"flush_operations"`
+	UnmapOperations        uint64  `json:"unmap_operations"`
+	ReadMerged             uint64  `json:"rd_merged"`
+	WriteMerged            uint64  `json:"wr_merged"`
+	UnmapMerged            uint64  `json:"unmap_merged"`
+	ReadTotalTimeNs        uint64  `json:"rd_total_time_ns"`
+	WriteTotalTimeNs       uint64  `json:"wr_total_time_ns"`
+	FlushTotalTimeNs       uint64  `json:"flush_total_time_ns"`
+	UnmapTotalTimeNs       uint64  `json:"unmap_total_time_ns"`
+	FailedReadOperations   uint64  `json:"failed_rd_operations"`
+	FailedWriteOperations  uint64  `json:"failed_wr_operations"`
+	FailedFlushOperations  uint64  `json:"failed_flush_operations"`
+	FailedUnmapOperations  uint64  `json:"failed_unmap_operations"`
+	InvalidReadOperations  uint64  `json:"invalid_rd_operations"`
+	InvalidWriteOperations uint64  `json:"invalid_wr_operations"`
+	InvalidFlushOperations uint64  `json:"invalid_flush_operations"`
+	InvalidUnmapOperations uint64  `json:"invalid_unmap_operations"`
+	WriteHighestOffset     uint64  `json:"wr_highest_offset"`
+	IdleTimeNs             uint64  `json:"idle_time_ns"`
+	AccountInvalid         IntBool `json:"account_invalid"`
+	AccountFailed          IntBool `json:"account_failed"`
+}
+
+// VMNICStat is the traffic of one network interface of a running VM.
+type VMNICStat struct {
+	NetIn  uint64 `json:"netin"`
+	NetOut uint64 `json:"netout"`
+}
+
+// VMHAStatus is the HA manager state of a VM.
+type VMHAStatus struct {
+	Managed IntBool `json:"managed"`
+	State   string  `json:"state"` // e.g. "started", "stopped", "disabled", "error"
+	Group   string  `json:"group"`
 }
 
+// VMProxmoxSupport lists the Proxmox specific features of the QEMU binary a VM runs with.
+type VMProxmoxSupport struct {
+	BackupMaxWorkers        IntBool `json:"backup-max-workers"`
+	PBSDirtyBitmap          IntBool `json:"pbs-dirty-bitmap"`
+	PBSDirtyBitmapMigration IntBool `json:"pbs-dirty-bitmap-migration"`
+	PBSDirtyBitmapSaveVM    IntBool `json:"pbs-dirty-bitmap-savevm"`
+	PBSLibraryVersion       string  `json:"pbs-library-version"`
+	PBSMasterKey            IntBool `json:"pbs-masterkey"`
+	QueryBitmapInfo         IntBool `json:"query-bitmap-info"`
+}
+
+// VMPendingConfigItem describes the current and the pending state of a single config key.
+type VMPendingConfigItem struct {
+	Key string `json:"key"`
+
+	// Current value, nil if the key is not set yet.
+	Value interface{} `json:"value"`
+
+	// Pending value, nil if there is no pending change.
+	Pending interface{} `json:"pending"`
+
+	// Indicates a pending delete request if not 0. The value 2 indicates a force-delete request.
+	Delete int `json:"delete"`
+}
+
+type VMPendingConfig []VMPendingConfigItem
+
+// Current returns the config that is currently in effect.
+func (p VMPendingConfig) Current() *VMConfig {
+	data := make(map[string]interface{})
+	for _, item := range p {
+		if item.Value != nil {
+			data[item.Key] = item.Value
+		}
+	}
+	return NewVMConfigFromMap(data)
+}
+
+// Pending returns the config that will be in effect once all pending changes are applied.
+func (p VMPendingConfig) Pending() *VMConfig {
+	data := make(map[string]interface{})
+	for _, item := range p {
+		if item.Delete != 0 {
+			continue
+		}
+		if item.Pending != nil {
+			data[item.Key] = item.Pending
+		} else if item.Value != nil {
+			data[item.Key] = item.Value
+		}
+	}
+	return NewVMConfigFromMap(data)
+}
+
+// PendingDeletes returns the keys with a pending delete request.
+func (p VMPendingConfig) PendingDeletes() []string {
+	keys := make([]string, 0)
+	for _, item := range p {
+		if item.Delete != 0 {
+			keys = append(keys, item.Key)
+		}
+	}
+	return keys
+}
+
+const vmStatusStopped = "stopped"
+
+type VMStartConfig struct {
+	SkipLock     *bool   // Ignore locks - only root is allowed to use this option
+	MigratedFrom *string // The cluster node name the VM is migrated from
+	Timeout      *int    // Wait maximal timeout seconds. Defaults to max(30, vm memory in GiB)
+}
+
+func (c *VMStartConfig) apiParams() *PostNodesQemuStatusStartParams {
+	if c == nil {
+		return nil
+	}
+	return &PostNodesQemuStatusStartParams{
+		SkipLock:     c.SkipLock,
+		MigratedFrom: c.MigratedFrom,
+		Timeout:      c.Timeout,
+	}
+}
+
+type VMStopConfig struct {
+	SkipLock     *bool   // Ignore locks - only root is allowed to use this option
+	KeepActive   *bool   // Do not deactivate storage volumes
+	MigratedFrom *string // The cluster node name the VM is migrated from
+	Timeout      *int    // Wait maximal timeout seconds
+}
+
+func (c *VMStopConfig) apiParams() *PostNodesQemuStatusStopParams {
+	if c == nil {
+		return nil
+	}
+	return &PostNodesQemuStatusStopParams{
+		SkipLock:     c.SkipLock,
+		KeepActive:   c.KeepActive,
+		MigratedFrom: c.MigratedFrom,
+		Timeout:      c.Timeout,
+	}
+}
+
+type VMShutdownConfig struct {
+	Timeout    *int  // Wait maximal timeout seconds
+	ForceStop  *bool // Make sure the VM stops: stop it hard if it is still running after the timeout
+	KeepActive *bool // Do not deactivate storage volumes
+	SkipLock   *bool // Ignore locks - only root is allowed to use this option
+}
+
+func (c *VMShutdownConfig) apiParams() *PostNodesQemuStatusShutdownParams {
+	if c == nil {
+		return nil
+	}
+	return &PostNodesQemuStatusShutdownParams{
+		Timeout:    c.Timeout,
+		ForceStop:  c.ForceStop,
+		KeepActive: c.KeepActive,
+		SkipLock:   c.SkipLock,
+	}
+}
+
+type VMRebootConfig struct {
+	Timeout *int // Wait maximal timeout seconds for the shutdown
+}
+
+func (c *VMRebootConfig) apiParams() *PostNodesQemuStatusRebootParams {
+	if c == nil {
+		return nil
+	}
+	return &PostNodesQemuStatusRebootParams{Timeout: c.Timeout}
+}
+
+// VMStopOutcome reports how EnsureStopped stopped a VM.
+type VMStopOutcome string
+
+const (
+	VMStopOutcomeAlreadyStopped VMStopOutcome = "already-stopped" // the VM was not running
+	VMStopOutcomeShutdown       VMStopOutcome = "shutdown"        // the guest shut down within the grace period
+	VMStopOutcomeForced         VMStopOutcome = "forced"          // the VM was stopped hard, after the grace period or without one
+)
+
 type VMCloneConfig struct {
 	Name          *string // Set a name for the new VM
 	Description   *string // Description for the new VM
@@ -90,42 +379,75 @@ type VMCloneConfig struct {
 	Storage       *string // Target storage for full clone
 	StorageFormat *string // Target format for file storage
 	TargetNode    *string // Target node. Only allowed if the original VM is on shared storage
+
+	// Don't check the storage features before the clone. The check needs up to two extra requests: the
+	// status of the VM when Full is not set, and the feature of the storages.
+	SkipFeatureCheck *bool
 }
 
-func (c *VMCloneConfig) getRequestBodyParameters() map[string]string {
-	bodyParams := map[string]string{}
-	if c.Name != nil {
-		bodyParams["name"] = StringValue(c.Name)
-	}
-	if c.Description != nil {
-		bodyParams["description"] = StringValue(c.Description)
+func (c *VMCloneConfig) apiParams() *PostNodesQemuCloneParams {
+	params := &PostNodesQemuCloneParams{
+		Name:        c.Name,
+		Description: c.Description,
+		Full:        c.Full,
+		Pool:        c.Pool,
+		SnapName:    c.SnapshotName,
+		Storage:     c.Storage,
+		Target:      c.TargetNode,
 	}
-	if c.Full != nil {
-		bodyParams["full"] = boolToString(BoolValue(c.Full))
-	}
-	if c.Pool != nil {
-		bodyParams["pool"] = StringValue(c.Pool)
+	if c.StorageFormat != nil {
+		format := PostNodesQemuCloneFormat(StringValue(c.StorageFormat))
+		params.Format = &format
 	}
-	if c.SnapshotName != nil {
-		bodyParams["snapname"] = StringValue(c.SnapshotName)
+	return params
+}
+
+type VMSnapshotConfig struct {
+	Description *string // A textual description or comment
+	VMState     *bool   // Save the vmstate
+
+	// Don't check the snapshot feature of the storages before the snapshot, which needs an extra request.
+	SkipFeatureCheck *bool
+}
+
+func (c *VMSnapshotConfig) apiParams() *PostNodesQemuSnapshotParams {
+	if c == nil {
+		return nil
 	}
-	if c.Storage != nil {
-		bodyParams["storage"] = StringValue(c.Storage)
+	return &PostNodesQemuSnapshotParams{
+		Description: c.Description,
+		VMState:     c.VMState,
 	}
-	if c.StorageFormat != nil {
-		bodyParams["format"] = StringValue(c.StorageFormat)
+}
+
+// VMFeatureStatus reports whether the storages of a VM support a feature.
+type VMFeatureStatus struct {
+	HasFeature bool
+	Nodes      []string // Nodes that have all storages of the VM, i.e. the possible targets of a clone
+}
+
+type VMMigrateConfig struct {
+	Online         *bool   // Migrate a running VM live. A running VM fails to migrate without it
+	WithLocalDisks *bool   // Copy the disks on local storages to the target node while the VM is running
+	TargetStorage  *string // Storage for the local disks on the target node, or a mapping like "local-lvm:fast,local:slow"
+	BandwidthLimit *int    // KiB/s. Defaults to the migrate limit of the datacenter or storage config
+}
+
+func (c *VMMigrateConfig) apiParams() *PostNodesQemuMigrateParams {
+	if c == nil {
+		return nil
 	}
-	if c.TargetNode != nil {
-		bodyParams["target"] = StringValue(c.TargetNode)
+	return &PostNodesQemuMigrateParams{
+		Online:         c.Online,
+		WithLocalDisks: c.WithLocalDisks,
+		TargetStorage:  c.TargetStorage,
+		BWLimit:        c.BandwidthLimit,
 	}
-	return bodyParams
 }
 
 // Virtual machine index (per node).
 func (s *QemuServiceOp) GetVMList(node string) ([]VM, error) {
-	path := fmt.Sprintf("nodes/%s/qemu", node)
-
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	req, err := s.client.API.newGetNodesQemuRequest(context.Background(), node, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -140,9 +462,13 @@ func (s *QemuServiceOp) GetVMList(node string) ([]VM, error) {
 
 // Get virtual machine status.
 func (s *QemuServiceOp) GetVMCurrentStatus(node string, vmID int) (*VMStatus, error) {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/current", node, vmID)
+	return s.getVMCurrentStatus(context.Background(), node, vmID)
+}
 
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+// getVMCurrentStatus decodes the status as VMStatus instead of GetNodesQemuStatusCurrentResponse, which
+// lacks the balloon, block device and NIC statistics.
+func (s *QemuServiceOp) getVMCurrentStatus(ctx context.Context, node string, vmID int) (*VMStatus, error) {
+	req, err := s.client.API.newGetNodesQemuStatusCurrentRequest(ctx, node, vmID)
 	if err != nil {
 		return nil, err
 	}
@@ -156,84 +482,94 @@ func (s *QemuServiceOp) GetVMCurrentStatus(node string, vmID int) (*VMStatus, er
 }
 
 // Start virtual machine.
-func (s *QemuServiceOp) StartVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/start", node, vmID)
-
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = s.client.Do(req, nil)
+func (s *QemuServiceOp) StartVM(ctx context.Context, node string, vmID int, config *VMStartConfig) error {
+	_, err := s.client.API.PostNodesQemuStatusStart(ctx, node, vmID, config.apiParams())
 	return err
 }
 
 // Stop virtual machine. The qemu process will exit immediately.
 // This is akin to pulling the power plug of a running computer and may damage the VM data.
-func (s *QemuServiceOp) StopVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/stop", node, vmID)
-
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = s.client.Do(req, nil)
+func (s *QemuServiceOp) StopVM(ctx context.Context, node string, vmID int, config *VMStopConfig) error {
+	_, err := s.client.API.PostNodesQemuStatusStop(ctx, node, vmID, config.apiParams())
 	return err
 }
 
 // Shutdown virtual machine. This is similar to pressing the power button on a physical machine.
 // This will send an ACPI event for the guest OS, which should then proceed to a clean shutdown.
-func (s *QemuServiceOp) ShutdownVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/shutdown", node, vmID)
+func (s *QemuServiceOp) ShutdownVM(ctx context.Context, node string, vmID int, config *VMShutdownConfig) error {
+	_, err := s.client.API.PostNodesQemuStatusShutdown(ctx, node, vmID, config.apiParams())
+	return err
+}
 
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
+// Reboot the VM by shutting it down, and starting it again. Applies pending changes.
+func (s *QemuServiceOp) RebootVM(ctx context.Context, node string, vmID int, config *VMRebootConfig) error {
+	_, err := s.client.API.PostNodesQemuStatusReboot(ctx, node, vmID, config.apiParams())
+	return err
+}
+
+// Stop the VM gracefully: an ACPI shutdown is sent and the VM gets grace to power off. If it is still running
+// afterwards it is stopped hard. With a grace of zero or less the VM is stopped hard right away. The returned
+// outcome tells which of the paths was taken.
+func (s *QemuServiceOp) EnsureStopped(ctx context.Context, node string, vmID int, grace time.Duration) (VMStopOutcome, error) {
+	status, err := s.getVMCurrentStatus(ctx, node, vmID)
 	if err != nil {
-		return err
+		return "", err
 	}
-	_, err = s.client.Do(req, nil)
-	return err
+	if status.Status == vmStatusStopped {
+		return VMStopOutcomeAlreadyStopped, nil
+	}
+
+	if grace > 0 {
+		shutdown := &VMShutdownConfig{Timeout: Int(int((grace + time.Second - 1) / time.Second))}
+		if _, err := s.client.API.PostNodesQemuStatusShutdown(ctx, node, vmID, shutdown.apiParams()); err != nil {
+			return "", err
+		}
+		_, err = s.WaitForVMStatus(ctx, node, vmID, VMStopped, &WaitConfig{Timeout: grace})
+		if err == nil {
+			return VMStopOutcomeShutdown, nil
+		}
+		if _, ok := err.(*WaitTimeoutError); !ok {
+			return "", err
+		}
+		log.Printf("[DEBUG] VM %d did not shut down within %s, stopping it\n", vmID, grace)
+	}
+
+	if _, err := s.client.API.PostNodesQemuStatusStop(ctx, node, vmID, nil); err != nil {
+		return "", err
+	}
+	if _, err := s.WaitForVMStatus(ctx, node, vmID, VMStopped, nil); err != nil {
+		return "", err
+	}
+	return VMStopOutcomeForced, nil
 }
 
 // Reset virtual machine.
 func (s *QemuServiceOp) ResetVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/reset", node, vmID)
-
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = s.client.Do(req, nil)
+	_, err := s.client.API.PostNodesQemuStatusReset(context.Background(), node, vmID, nil)
 	return err
 }
 
 // Suspend virtual machine.
 func (s *QemuServiceOp) SuspendVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/suspend", node, vmID)
-
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = s.client.Do(req, nil)
+	_, err := s.client.API.PostNodesQemuStatusSuspend(context.Background(), node, vmID, nil)
 	return err
 }
 
 // Resume virtual machine.
 func (s *QemuServiceOp) ResumeVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/status/resume", node, vmID)
-
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = s.client.Do(req, nil)
+	_, err := s.client.API.PostNodesQemuStatusResume(context.Background(), node, vmID, nil)
 	return err
 }
 
 // Get config for the virtual machine
 func (s *QemuServiceOp) GetVMConfig(node string, vmID int) (*VMConfig, error) {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/config", node, vmID)
+	return s.getVMConfig(context.Background(), node, vmID)
+}
 
-	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+// getVMConfig decodes the config as map instead of GetNodesQemuConfigResponse, so that NewVMConfigFromMap
+// sees every key the API returns.
+func (s *QemuServiceOp) getVMConfig(ctx context.Context, node string, vmID int) (*VMConfig, error) {
+	req, err := s.client.API.newGetNodesQemuConfigRequest(ctx, node, vmID, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -246,98 +582,502 @@ func (s *QemuServiceOp) GetVMConfig(node string, vmID int) (*VMConfig, error) {
 	return config, nil
 }
 
-// Create virtual machine.
+// Get the virtual machine configuration with both current and pending values.
+func (s *QemuServiceOp) GetVMPendingConfig(node string, vmID int) (VMPendingConfig, error) {
+	req, err := s.client.API.newGetNodesQemuPendingRequest(context.Background(), node, vmID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(vmPendingConfigRoot)
+	if _, err = s.client.Do(req, root); err != nil {
+		return nil, err
+	}
+
+	return root.Items, err
+}
+
+// Create virtual machine. Fails with a VMAlreadyExistsError if vmID is used anywhere in the cluster.
 func (s *QemuServiceOp) CreateVM(node string, vmID int, config *VMConfig) error {
-	if config == nil {
-		config = &VMConfig{}
+	config, err := prepareCreateVMConfig(vmID, config)
+	if err != nil {
+		return err
 	}
-	config.VMID = Int(vmID)
 
-	if vms, err := s.GetVMList(node); err != nil {
+	ctx := context.Background()
+	if _, err := s.client.API.GetClusterNextID(ctx, &GetClusterNextIDParams{VMID: Int(vmID)}); err != nil {
 		return err
-	} else {
-		for _, vm := range vms {
-			if vmID == vm.VMID {
-				return errors.New(fmt.Sprintf("VM with id %d already exists", vmID))
+	}
+	return s.createVM(ctx, node, config)
+}
+
+// Create virtual machine with the next free VMID within vmIDRange (optional) and return the VMID.
+// If another client takes the VMID in the meantime, a new VMID is allocated and the creation is retried.
+func (s *QemuServiceOp) CreateVMAuto(ctx context.Context, node string, config *VMConfig, vmIDRange *VMIDRange) (int, error) {
+	for attempt := 1; ; attempt++ {
+		vmID, err := s.client.Cluster.NextVMID(ctx, vmIDRange)
+		if err != nil {
+			return 0, err
+		}
+		config, err = prepareCreateVMConfig(vmID, config)
+		if err != nil {
+			return 0, err
+		}
+
+		err = s.createVM(ctx, node, config)
+		if _, ok := err.(*VMAlreadyExistsError); !ok {
+			if err != nil {
+				return 0, err
 			}
+			return vmID, nil
+		}
+		if attempt == createVMAutoAttempts {
+			return 0, err
+		}
+		log.Printf("[DEBUG] VMID %d was taken concurrently, retrying (attempt %d)\n", vmID, attempt)
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(time.Duration(attempt) * 100 * time.Millisecond):
 		}
 	}
+}
+
+func prepareCreateVMConfig(vmID int, config *VMConfig) (*VMConfig, error) {
+	if config == nil {
+		config = &VMConfig{}
+	}
+	config.VMID = Int(vmID)
+
+	if config.Bios != nil && *config.Bios == BIOS_OVMF && config.EFIDisk == nil {
+		return nil, NewArgError(parameterEFIDisk, "an EFI disk is required when using the OVMF BIOS")
+	}
+	return config, nil
+}
 
-	path := fmt.Sprintf("nodes/%s/qemu", node)
+func (s *QemuServiceOp) createVM(ctx context.Context, node string, config *VMConfig) error {
 	optionsMap, err := config.GetOptionsMap()
 	if err != nil {
 		return err
 	}
-	req, err := s.client.NewRequest(http.MethodPost, path, optionsMap)
-	if err != nil {
+	delete(optionsMap, parameterVMID)
+	delete(optionsMap, parameterDigest)
+	params := new(PostNodesQemuParams)
+	if err := setParams(params, optionsMap); err != nil {
 		return err
 	}
-	_, err = s.client.Do(req, nil)
+	_, err = s.client.API.PostNodesQemu(ctx, node, IntValue(config.VMID), params)
 	return err
 }
 
-// Update virtual machine.
+// Update virtual machine. If config.Digest is set, the update fails with a VMConfigConflictError
+// when the configuration was modified in the meantime.
 func (s *QemuServiceOp) UpdateVM(node string, vmID int, config *VMConfig, async bool) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/config", node, vmID)
-	method := http.MethodPut // synchronous API
-	if async == true {
-		method = http.MethodPost // asynchronous API
-	}
 	optionsMap, err := config.GetOptionsMap()
 	if err != nil {
 		return err
 	}
-	req, err := s.client.NewRequest(method, path, optionsMap)
-	if err != nil {
+	return s.updateVMOptions(context.Background(), node, vmID, optionsMap, async)
+}
+
+func (s *QemuServiceOp) updateVMOptions(ctx context.Context, node string, vmID int, optionsMap map[string]string, async bool) error {
+	options := make(map[string]string, len(optionsMap))
+	for k, v := range optionsMap {
+		if k != parameterVMID {
+			options[k] = v
+		}
+	}
+	if async == true {
+		params := new(PostNodesQemuConfigParams)
+		if err := setParams(params, options); err != nil {
+			return err
+		}
+		_, err := s.client.API.PostNodesQemuConfig(ctx, node, vmID, params)
 		return err
 	}
-	_, err = s.client.Do(req, nil)
-	return err
+	params := new(PutNodesQemuConfigParams)
+	if err := setParams(params, options); err != nil {
+		return err
+	}
+	return s.client.API.PutNodesQemuConfig(ctx, node, vmID, params)
 }
 
-// Create virtual machine.
-func (s *QemuServiceOp) DeleteVM(node string, vmID int) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d", node, vmID)
+// Update virtual machine using read-modify-write. The current config is passed to mutate and the changed
+// options are sent together with the config digest. Options that mutate removes from the config are
+// deleted. On a concurrent modification the whole cycle is repeated until the update succeeds, mutate
+// returns an error or ctx is done.
+func (s *QemuServiceOp) UpdateVMWithRetry(ctx context.Context, node string, vmID int, mutate func(*VMConfig) error) error {
+	for attempt := 1; ; attempt++ {
+		config, err := s.getVMConfig(ctx, node, vmID)
+		if err != nil {
+			return err
+		}
+		digest := StringValue(config.Digest)
+		before, err := config.GetOptionsMap()
+		if err != nil {
+			return err
+		}
+		if err := mutate(config); err != nil {
+			return err
+		}
+		after, err := config.GetOptionsMap()
+		if err != nil {
+			return err
+		}
+
+		changes := make(map[string]string)
+		for k, v := range after {
+			if previous, ok := before[k]; !ok || previous != v {
+				changes[k] = v
+			}
+		}
+		deletes := config.Delete
+		for k := range before {
+			if _, ok := after[k]; !ok && k != parameterDigest {
+				deletes = append(deletes, k)
+			}
+		}
+		if len(deletes) > 0 {
+			changes[parameterDelete] = strings.Join(deletes, ",")
+		}
+		delete(changes, parameterDigest)
+		if len(changes) == 0 {
+			return nil
+		}
+		changes[parameterDigest] = digest
+
+		err = s.updateVMOptions(ctx, node, vmID, changes, false)
+		if _, ok := err.(*VMConfigConflictError); !ok {
+			return err
+		}
+		log.Printf("[DEBUG] Config of VM %d was modified concurrently, retrying (attempt %d)\n", vmID, attempt)
 
-	req, err := s.client.NewRequest(http.MethodDelete, path, nil)
+		backoff := time.Duration(attempt) * 100 * time.Millisecond
+		if backoff > 2*time.Second {
+			backoff = 2 * time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// Apply a diff created by DiffVMConfig. Added, changed and removed options are sent with a single
+// config update first, followed by the disk resize and move operations in the order of the diff. Each disk
+// operation waits for the task of the previous one to finish. The source volumes of moved disks are kept as
+// unusedN unless config.DeleteMovedDisks is set.
+func (s *QemuServiceOp) ApplyVMConfigDiff(ctx context.Context, node string, vmID int, diff *VMConfigDiff, config *VMConfigDiffApplyConfig) error {
+	optionsMap := make(map[string]string)
+	deletes := make([]string, 0)
+	for _, change := range diff.Changes {
+		if change.DiskOperation != "" {
+			continue
+		}
+		if change.Type == VMConfigChangeRemoved {
+			deletes = append(deletes, change.Key)
+		} else {
+			optionsMap[change.Key] = change.New
+		}
+	}
+	if len(deletes) > 0 {
+		optionsMap[parameterDelete] = strings.Join(deletes, ",")
+	}
+	if len(optionsMap) > 0 {
+		if diff.Digest != "" {
+			optionsMap[parameterDigest] = diff.Digest
+		}
+		if err := s.updateVMOptions(ctx, node, vmID, optionsMap, false); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range diff.Changes {
+		var task *Task
+		var err error
+		switch change.DiskOperation {
+		case VMDiskOperationResize:
+			task, err = s.ResizeVMDisk(ctx, node, vmID, change.Key, change.New)
+		case VMDiskOperationMove:
+			task, err = s.MoveVMDisk(ctx, node, vmID, change.Key, change.New, config != nil && config.DeleteMovedDisks)
+		}
+		if err != nil {
+			return err
+		}
+		if task != nil {
+			if _, err := task.Wait(ctx, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Extend volume size. The size is either absolute, e.g. "64G", or relative with a leading '+', e.g. "+10G".
+// Wait on the returned task for the resize to finish. The task is nil if Proxmox resized the disk
+// synchronously, as versions before 7.3 do.
+func (s *QemuServiceOp) ResizeVMDisk(ctx context.Context, node string, vmID int, disk string, size string) (*Task, error) {
+	upid, err := s.client.API.PutNodesQemuResize(ctx, node, vmID, PutNodesQemuResizeDisk(disk), size, nil)
+	if err != nil || upid == "" {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// Move volume to different storage. Wait on the returned task for the move to finish.
+func (s *QemuServiceOp) MoveVMDisk(ctx context.Context, node string, vmID int, disk string, storage string, deleteSource bool) (*Task, error) {
+	params := &PostNodesQemuMoveDiskParams{
+		Storage: String(storage),
+		Delete:  Bool(deleteSource),
+	}
+	upid, err := s.client.API.PostNodesQemuMoveDisk(ctx, node, vmID, PostNodesQemuMoveDiskDisk(disk), params)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = s.client.Do(req, nil)
+	return s.client.Task(upid)
+}
+
+// Destroy the VM and all used/owned volumes.
+func (s *QemuServiceOp) DeleteVM(node string, vmID int) error {
+	_, err := s.client.API.DeleteNodesQemuVMID(context.Background(), node, vmID, nil)
 	return err
 }
 
 // Create a template from VM.
 func (s *QemuServiceOp) CreateVMTemplate(node string, vmID int, disk string) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/template", node, vmID)
-
-	body := make(map[string]string)
+	params := new(PostNodesQemuTemplateParams)
 	if disk != "" {
-		body["disk"] = disk
+		diskValue := PostNodesQemuTemplateDisk(disk)
+		params.Disk = &diskValue
 	}
+	_, err := s.client.API.PostNodesQemuTemplate(context.Background(), node, vmID, params)
+	return err
+}
 
-	req, err := s.client.NewRequest(http.MethodPost, path, body)
-	if err != nil {
+// Clone VM. A linked clone, the default for templates, fails with a FeatureNotSupportedError before anything
+// is created if the storages of the template don't support it. For this check CloneVM requests the status
+// of the VM when config.Full is not set, and the clone or copy feature of its storages when a linked clone,
+// a snapshot or another target node needs it. Set config.SkipFeatureCheck to leave the check to PVE.
+func (s *QemuServiceOp) CloneVM(node string, vmID int, newID int, config *VMCloneConfig) error {
+	ctx := context.Background()
+	if err := s.checkCloneFeatures(ctx, node, vmID, config); err != nil {
 		return err
 	}
-	_, err = s.client.Do(req, nil)
+	_, err := s.cloneVM(ctx, node, vmID, newID, config)
 	return err
 }
 
-// Clone VM.
-func (s *QemuServiceOp) CloneVM(node string, vmID int, newID int, config *VMCloneConfig) error {
-	path := fmt.Sprintf("nodes/%s/qemu/%d/clone", node, vmID)
-	body := make(map[string]string)
-	body["newid"] = strconv.Itoa(newID)
+// cloneVM starts the clone without checking the features of the storages.
+func (s *QemuServiceOp) cloneVM(ctx context.Context, node string, vmID int, newID int, config *VMCloneConfig) (*Task, error) {
+	var params *PostNodesQemuCloneParams
 	if config != nil {
-		for k, v := range config.getRequestBodyParameters() {
-			body[k] = v
+		params = config.apiParams()
+	}
+	upid, err := s.client.API.PostNodesQemuClone(ctx, node, vmID, newID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// Migrate the VM to the target node. Wait on the returned task for the migration to finish.
+func (s *QemuServiceOp) MigrateVM(ctx context.Context, node string, vmID int, target string, config *VMMigrateConfig) (*Task, error) {
+	if target == "" {
+		return nil, NewArgError("target", "cannot be empty")
+	}
+	if target == node {
+		return nil, NewArgError("target", "must differ from the source node")
+	}
+	upid, err := s.client.API.PostNodesQemuMigrate(ctx, node, vmID, target, config.apiParams())
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// checkCloneFeatures fails with a FeatureNotSupportedError if the storages of the VM can't do the clone
+// described by config: a linked clone needs the clone feature, a full clone of a snapshot the copy feature,
+// and the target node all storages of the VM.
+func (s *QemuServiceOp) checkCloneFeatures(ctx context.Context, node string, vmID int, config *VMCloneConfig) error {
+	if config != nil && BoolValue(config.SkipFeatureCheck) {
+		return nil
+	}
+	var full *bool
+	var snapshotName, targetNode string
+	if config != nil {
+		full = config.Full
+		snapshotName = StringValue(config.SnapshotName)
+		targetNode = StringValue(config.TargetNode)
+	}
+	if full == nil {
+		// PVE clones templates linked and other VMs fully by default
+		status, err := s.getVMCurrentStatus(ctx, node, vmID)
+		if err != nil {
+			return err
 		}
+		full = Bool(!bool(status.Template))
+	}
+	linked := !BoolValue(full)
+	if !linked && snapshotName == "" && targetNode == "" {
+		return nil
+	}
+
+	feature := VMFeature_Copy
+	if linked {
+		feature = VMFeature_Clone
 	}
-	req, err := s.client.NewRequest(http.MethodPost, path, body)
+	status, err := s.GetVMFeature(ctx, node, vmID, feature, snapshotName)
 	if err != nil {
 		return err
 	}
-	_, err = s.client.Do(req, nil)
+	if (linked || snapshotName != "") && !status.HasFeature {
+		return &FeatureNotSupportedError{VMID: vmID, Feature: feature, SnapshotName: snapshotName}
+	}
+	if targetNode != "" && targetNode != node {
+		for _, n := range status.Nodes {
+			if n == targetNode {
+				return nil
+			}
+		}
+		return &FeatureNotSupportedError{VMID: vmID, Feature: feature, SnapshotName: snapshotName, Node: targetNode}
+	}
+	return nil
+}
+
+// Check whether the storages of the VM support a feature, for the snapshot snapshotName if it's not empty.
+func (s *QemuServiceOp) GetVMFeature(ctx context.Context, node string, vmID int, feature VMFeature, snapshotName string) (*VMFeatureStatus, error) {
+	var params *GetNodesQemuFeatureParams
+	if snapshotName != "" {
+		params = &GetNodesQemuFeatureParams{SnapName: String(snapshotName)}
+	}
+	response, err := s.client.API.GetNodesQemuFeature(ctx, node, vmID, GetNodesQemuFeatureFeature(feature), params)
+	if err != nil {
+		return nil, err
+	}
+	return &VMFeatureStatus{HasFeature: bool(response.HasFeature), Nodes: response.Nodes}, nil
+}
+
+// Snapshot the VM. The snapshot fails with a FeatureNotSupportedError before it is started if a storage of
+// the VM can't take snapshots, unless config.SkipFeatureCheck is set. Wait on the returned task for the
+// snapshot to finish.
+func (s *QemuServiceOp) CreateVMSnapshot(ctx context.Context, node string, vmID int, name string, config *VMSnapshotConfig) (*Task, error) {
+	if name == "" {
+		return nil, NewArgError("name", "cannot be empty")
+	}
+	if config == nil || !BoolValue(config.SkipFeatureCheck) {
+		status, err := s.GetVMFeature(ctx, node, vmID, VMFeature_Snapshot, "")
+		if err != nil {
+			return nil, err
+		}
+		if !status.HasFeature {
+			return nil, &FeatureNotSupportedError{VMID: vmID, Feature: VMFeature_Snapshot}
+		}
+	}
+	upid, err := s.client.API.PostNodesQemuSnapshot(ctx, node, vmID, name, config.apiParams())
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Task(upid)
+}
+
+// withVMNode resolves the node of the QEMU VM vmID and calls fn with it. If the VM is not found on the node,
+// e.g. because it was migrated since the node was cached, the node is resolved once more.
+func (s *QemuServiceOp) withVMNode(ctx context.Context, vmID int, fn func(node string) error) error {
+	for attempt := 1; ; attempt++ {
+		location, err := s.client.Cluster.FindVM(ctx, vmID)
+		if err != nil {
+			return err
+		}
+		if location.Type != "qemu" {
+			return NewArgError("vmID", fmt.Sprintf("%d is not a QEMU VM but of type %s", vmID, location.Type))
+		}
+
+		err = fn(location.Node)
+		if _, ok := err.(*VMDoesNotExistError); !ok || attempt > 1 {
+			return err
+		}
+		s.client.Cluster.InvalidateResourceCache()
+	}
+}
+
+// Get virtual machine status without knowing its node.
+func (s *QemuServiceOp) GetVMCurrentStatusByID(ctx context.Context, vmID int) (*VMStatus, error) {
+	var status *VMStatus
+	err := s.withVMNode(ctx, vmID, func(node string) (err error) {
+		status, err = s.GetVMCurrentStatus(node, vmID)
+		return err
+	})
+	return status, err
+}
+
+// Get config for the virtual machine without knowing its node.
+func (s *QemuServiceOp) GetVMConfigByID(ctx context.Context, vmID int) (*VMConfig, error) {
+	var config *VMConfig
+	err := s.withVMNode(ctx, vmID, func(node string) (err error) {
+		config, err = s.GetVMConfig(node, vmID)
+		return err
+	})
+	return config, err
+}
+
+// Start virtual machine without knowing its node.
+func (s *QemuServiceOp) StartVMByID(ctx context.Context, vmID int, config *VMStartConfig) error {
+	return s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.PostNodesQemuStatusStart(ctx, node, vmID, config.apiParams())
+		return err
+	})
+}
+
+// Stop virtual machine without knowing its node.
+func (s *QemuServiceOp) StopVMByID(ctx context.Context, vmID int, config *VMStopConfig) error {
+	return s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.PostNodesQemuStatusStop(ctx, node, vmID, config.apiParams())
+		return err
+	})
+}
+
+// Shutdown virtual machine without knowing its node.
+func (s *QemuServiceOp) ShutdownVMByID(ctx context.Context, vmID int, config *VMShutdownConfig) error {
+	return s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.PostNodesQemuStatusShutdown(ctx, node, vmID, config.apiParams())
+		return err
+	})
+}
+
+// Reset virtual machine without knowing its node.
+func (s *QemuServiceOp) ResetVMByID(ctx context.Context, vmID int) error {
+	return s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.PostNodesQemuStatusReset(ctx, node, vmID, nil)
+		return err
+	})
+}
+
+// Suspend virtual machine without knowing its node.
+func (s *QemuServiceOp) SuspendVMByID(ctx context.Context, vmID int) error {
+	return s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.PostNodesQemuStatusSuspend(ctx, node, vmID, nil)
+		return err
+	})
+}
+
+// Resume virtual machine without knowing its node.
+func (s *QemuServiceOp) ResumeVMByID(ctx context.Context, vmID int) error {
+	return s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.PostNodesQemuStatusResume(ctx, node, vmID, nil)
+		return err
+	})
+}
+
+// Destroy the VM and all used/owned volumes without knowing its node.
+func (s *QemuServiceOp) DeleteVMByID(ctx context.Context, vmID int) error {
+	err := s.withVMNode(ctx, vmID, func(node string) error {
+		_, err := s.client.API.DeleteNodesQemuVMID(ctx, node, vmID, nil)
+		return err
+	})
+	if err == nil {
+		s.client.Cluster.InvalidateResourceCache()
+	}
 	return err
 }
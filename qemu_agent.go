@@ -0,0 +1,338 @@
+package goproxmox
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Largest chunk file-write takes: 61440 characters of base64
+	maxFileWriteChunk = 61440 / 4 * 3
+
+	// Default size of the chunks PullFile reads with dd once file-read truncated the file
+	defaultPullChunk = 4 * 1024 * 1024
+
+	// Suffix of the chunk files PushFile concatenates in the guest
+	pushPartSuffix = ".goproxmox-part-"
+
+	// Removes the chunk files of the path passed as $0, which keeps the path out of the script
+	removePushPartsScript = `rm -f "$0"` + pushPartSuffix + `*`
+)
+
+// AgentCommandStatus is the status of a command started by ExecAgentCommand.
+type AgentCommandStatus struct {
+	Exited   bool
+	ExitCode int
+	Signal   int // Set if the process was terminated abnormally
+	Stdout   []byte
+	Stderr   []byte
+
+	// The guest agent captures at most 16MiB of each stream
+	StdoutTruncated bool
+	StderrTruncated bool
+}
+
+// FileTransferConfig holds the optional parameters of PushFile and PullFile.
+type FileTransferConfig struct {
+	// Size of the chunks. Pushed chunks are at most 46080 bytes, the limit of file-write, which is also the
+	// default. Pulled chunks default to 4MiB and are only used for files larger than file-read returns
+	ChunkSize int
+
+	// Don't compare the sha256 checksum of the guest file, e.g. for guests without sha256sum
+	SkipVerify bool
+
+	// Called after every chunk with the bytes transferred so far and the total, -1 if unknown
+	Progress func(transferred, total int64)
+}
+
+func (c *FileTransferConfig) chunkSize(defaultSize int) int {
+	if c == nil || c.ChunkSize <= 0 || c.ChunkSize > defaultSize {
+		return defaultSize
+	}
+	return c.ChunkSize
+}
+
+func (c *FileTransferConfig) progress(transferred, total int64) {
+	if c != nil && c.Progress != nil {
+		c.Progress(transferred, total)
+	}
+}
+
+// Start a command in the VM via the guest agent and return its PID. The command is not run in a shell.
+func (s *QemuServiceOp) ExecAgentCommand(ctx context.Context, node string, vmID int, command []string, input string) (int, error) {
+	if len(command) == 0 {
+		return 0, NewArgError("command", "cannot be empty")
+	}
+	var params *PostNodesQemuAgentExecParams
+	if input != "" {
+		params = &PostNodesQemuAgentExecParams{InputData: String(input)}
+	}
+	response, err := s.client.API.PostNodesQemuAgentExec(ctx, node, vmID, command, params)
+	if err != nil {
+		return 0, err
+	}
+	return int(response.PID), nil
+}
+
+// Get the status of a command started by ExecAgentCommand. The output is only set once the command exited.
+func (s *QemuServiceOp) GetAgentCommandStatus(ctx context.Context, node string, vmID int, pid int) (*AgentCommandStatus, error) {
+	response, err := s.client.API.GetNodesQemuAgentExecStatus(ctx, node, vmID, pid)
+	if err != nil {
+		return nil, err
+	}
+	return &AgentCommandStatus{
+		Exited:          bool(response.Exited),
+		ExitCode:        int(response.ExitCode),
+		Signal:          int(response.Signal),
+		Stdout:          agentBytes(response.OutData),
+		Stderr:          agentBytes(response.ErrData),
+		StdoutTruncated: bool(response.OutTruncated),
+		StderrTruncated: bool(response.ErrTruncated),
+	}, nil
+}
+
+// Run a command in the VM via the guest agent and wait until it exited. A non-zero exit code is not an
+// error, check AgentCommandStatus.ExitCode.
+func (s *QemuServiceOp) RunAgentCommand(ctx context.Context, node string, vmID int, command []string, input string) (*AgentCommandStatus, error) {
+	pid, err := s.ExecAgentCommand(ctx, node, vmID, command, input)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := 100 * time.Millisecond
+	for {
+		status, err := s.GetAgentCommandStatus(ctx, node, vmID, pid)
+		if err != nil {
+			return nil, err
+		}
+		if status.Exited {
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		if interval < time.Second {
+			interval *= 2
+		}
+	}
+}
+
+// runAgentCommand is RunAgentCommand failing with an AgentCommandError unless the command succeeded.
+func (s *QemuServiceOp) runAgentCommand(ctx context.Context, node string, vmID int, command ...string) (*AgentCommandStatus, error) {
+	status, err := s.RunAgentCommand(ctx, node, vmID, command, "")
+	if err != nil {
+		return nil, err
+	}
+	if status.ExitCode != 0 || status.Signal != 0 {
+		return nil, &AgentCommandError{Command: command, ExitCode: status.ExitCode, Stderr: string(status.Stderr)}
+	}
+	return status, nil
+}
+
+// Read a file in the VM via the guest agent. The guest agent returns at most 16MiB; truncated reports
+// whether the file is larger. Use PullFile for files of any size.
+func (s *QemuServiceOp) ReadGuestFile(ctx context.Context, node string, vmID int, path string) ([]byte, bool, error) {
+	response, err := s.client.API.GetNodesQemuAgentFileRead(ctx, node, vmID, path)
+	if err != nil {
+		return nil, false, err
+	}
+	return agentBytes(response.Content), bool(response.Truncated), nil
+}
+
+// Write a file in the VM via the guest agent, replacing its content. content may be at most 46080 bytes,
+// use PushFile for larger files.
+func (s *QemuServiceOp) WriteGuestFile(ctx context.Context, node string, vmID int, path string, content []byte) error {
+	if len(content) > maxFileWriteChunk {
+		return NewArgError("content", fmt.Sprintf("%d bytes exceed the limit of %d bytes", len(content), maxFileWriteChunk))
+	}
+	encoded := base64.StdEncoding.EncodeToString(content)
+	return s.client.API.PostNodesQemuAgentFileWrite(ctx, node, vmID, encoded, path, &PostNodesQemuAgentFileWriteParams{Encode: Bool(false)})
+}
+
+// Copy r to guestPath in the VM via the guest agent. Files larger than a chunk are written in parts next
+// to guestPath and concatenated with sh, so this requires a POSIX guest; so does the checksum check with
+// sha256sum unless config.SkipVerify is set.
+func (s *QemuServiceOp) PushFile(ctx context.Context, node string, vmID int, r io.Reader, guestPath string, config *FileTransferConfig) error {
+	chunkSize := config.chunkSize(maxFileWriteChunk)
+	total := readerSize(r)
+	hash := sha256.New()
+
+	var transferred int64
+	chunked := false
+	chunk := make([]byte, chunkSize)
+	for part := 0; ; part++ {
+		n, err := io.ReadFull(r, chunk)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			if chunked {
+				s.removePushParts(node, vmID, guestPath)
+			}
+			return err
+		}
+		if n == 0 && chunked {
+			// the previous chunk ended exactly at the end of r
+			break
+		}
+		hash.Write(chunk[:n])
+
+		// a file that fits into one chunk is written directly
+		path := guestPath
+		if !last || chunked {
+			if !chunked {
+				// parts left over by an aborted push would end up in the file
+				if _, err := s.runAgentCommand(ctx, node, vmID, "sh", "-c", removePushPartsScript, guestPath); err != nil {
+					return err
+				}
+				chunked = true
+			}
+			path = fmt.Sprintf("%s%s%06d", guestPath, pushPartSuffix, part)
+		}
+		if err := s.WriteGuestFile(ctx, node, vmID, path, chunk[:n]); err != nil {
+			if chunked {
+				s.removePushParts(node, vmID, guestPath)
+			}
+			return err
+		}
+		transferred += int64(n)
+		config.progress(transferred, total)
+		if last {
+			break
+		}
+	}
+	if chunked {
+		if err := s.concatPushParts(ctx, node, vmID, guestPath); err != nil {
+			s.removePushParts(node, vmID, guestPath)
+			return err
+		}
+	}
+
+	if config != nil && config.SkipVerify {
+		return nil
+	}
+	return s.verifyGuestFile(ctx, node, vmID, guestPath, hex.EncodeToString(hash.Sum(nil)))
+}
+
+func (s *QemuServiceOp) concatPushParts(ctx context.Context, node string, vmID int, guestPath string) error {
+	// the parts sort by name thanks to the fixed width part number
+	script := `cat "$0"` + pushPartSuffix + `* > "$0" && ` + removePushPartsScript
+	_, err := s.runAgentCommand(ctx, node, vmID, "sh", "-c", script, guestPath)
+	return err
+}
+
+// removePushParts cleans up after a failed push, also if ctx is done already.
+func (s *QemuServiceOp) removePushParts(node string, vmID int, guestPath string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	s.runAgentCommand(ctx, node, vmID, "sh", "-c", removePushPartsScript, guestPath)
+}
+
+// Copy guestPath in the VM to w via the guest agent. Files up to 16MiB are read with file-read, the rest of
+// larger files with dd, which requires a POSIX guest; so does the checksum check with sha256sum unless
+// config.SkipVerify is set.
+func (s *QemuServiceOp) PullFile(ctx context.Context, node string, vmID int, guestPath string, w io.Writer, config *FileTransferConfig) error {
+	hash := sha256.New()
+	out := io.MultiWriter(w, hash)
+
+	content, truncated, err := s.ReadGuestFile(ctx, node, vmID, guestPath)
+	if err != nil {
+		return err
+	}
+	total := int64(-1)
+	if !truncated {
+		total = int64(len(content))
+	} else if status, err := s.runAgentCommand(ctx, node, vmID, "stat", "-c", "%s", guestPath); err == nil {
+		if size, err := strconv.ParseInt(strings.TrimSpace(string(status.Stdout)), 10, 64); err == nil {
+			total = size
+		}
+	}
+	if _, err := out.Write(content); err != nil {
+		return err
+	}
+	transferred := int64(len(content))
+	config.progress(transferred, total)
+
+	chunkSize := config.chunkSize(defaultPullChunk)
+	for truncated {
+		status, err := s.runAgentCommand(ctx, node, vmID, "dd", "if="+guestPath, "iflag=skip_bytes,count_bytes",
+			fmt.Sprintf("skip=%d", transferred), fmt.Sprintf("count=%d", chunkSize), "bs=65536", "status=none")
+		if err != nil {
+			return err
+		}
+		if status.StdoutTruncated {
+			return fmt.Errorf("guest agent truncated chunk at offset %d of %s, use a smaller chunk size", transferred, guestPath)
+		}
+		if _, err := out.Write(status.Stdout); err != nil {
+			return err
+		}
+		transferred += int64(len(status.Stdout))
+		config.progress(transferred, total)
+		truncated = len(status.Stdout) == chunkSize
+	}
+
+	if config != nil && config.SkipVerify {
+		return nil
+	}
+	return s.verifyGuestFile(ctx, node, vmID, guestPath, hex.EncodeToString(hash.Sum(nil)))
+}
+
+// verifyGuestFile compares the sha256 checksum of guestPath with expected.
+func (s *QemuServiceOp) verifyGuestFile(ctx context.Context, node string, vmID int, guestPath string, expected string) error {
+	status, err := s.runAgentCommand(ctx, node, vmID, "sha256sum", guestPath)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(status.Stdout))
+	if len(fields) == 0 || fields[0] != expected {
+		actual := ""
+		if len(fields) > 0 {
+			actual = fields[0]
+		}
+		return &FileChecksumError{Path: guestPath, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// agentBytes restores binary data returned by the guest agent endpoints. PVE decodes the base64 of the
+// agent and sends every byte as a character, so the characters of the string are the bytes.
+func agentBytes(s string) []byte {
+	data := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > 0xff {
+			// not byte-wise encoded after all
+			return []byte(s)
+		}
+		data = append(data, byte(r))
+	}
+	return data
+}
+
+// readerSize returns the number of bytes left in r if r knows it, otherwise -1.
+func readerSize(r io.Reader) int64 {
+	switch reader := r.(type) {
+	case interface {
+		Len() int
+	}:
+		return int64(reader.Len())
+	case *os.File:
+		info, err := reader.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		offset, err := reader.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		return info.Size() - offset
+	}
+	return -1
+}
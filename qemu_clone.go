@@ -0,0 +1,343 @@
+package goproxmox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+)
+
+// Default number of clones CloneBatch runs at once per storage.
+const defaultClonesPerStorage = 2
+
+// ErrCloneNotStarted is the error of the clones CloneBatch didn't start because another clone failed and
+// CloneBatchOptions.Rollback is set.
+var ErrCloneNotStarted = errors.New("clone not started because another clone of the batch failed")
+
+// CloneBatchOptions holds the optional parameters of CloneBatch.
+type CloneBatchOptions struct {
+	// Name of the clones as text/template, executed with the CloneResult of the clone, e.g. "lab-{{.Index}}"
+	// or `lab-{{printf "%02d" .Index}}`. Without a pattern PVE names the clones
+	NamePattern string
+
+	// Index of the first clone. Defaults to 1
+	FirstIndex *int
+
+	// Nodes the clones are spread across, round robin. Defaults to the node of the template. Other nodes
+	// require that the template is on shared storage
+	Nodes []string
+
+	// Storages the clones are spread across, round robin. Storages imply full clones. Defaults to the
+	// storages of the template
+	Storages []string
+
+	// Range of the VMIDs of the clones. VMIDs are allocated in ascending order
+	VMIDRange *VMIDRange
+
+	// Number of clones running at once per storage. Defaults to 2
+	ClonesPerStorage int
+
+	// Delete the clones that succeeded if any clone failed, and don't start further clones after a failure.
+	// Clones still running when ctx is done or their wait timed out are waited for and deleted as well
+	Rollback bool
+
+	// Settings of every clone. Name, Storage and TargetNode are set per clone
+	CloneConfig *VMCloneConfig
+
+	// Polling of the clone tasks
+	Wait *WaitConfig
+}
+
+// CloneResult is the outcome of a clone of CloneBatch.
+type CloneResult struct {
+	Index   int
+	VMID    int
+	Name    string
+	Node    string
+	Storage string // Empty if the clone uses the storages of the template
+
+	Err      error
+	Duration time.Duration
+
+	// Set if the clone was deleted because another clone failed, or why it couldn't be deleted
+	RolledBack  bool
+	RollbackErr error
+}
+
+// CloneResults are the results of CloneBatch in the order of the indexes.
+type CloneResults []CloneResult
+
+// Failed returns the results with an error.
+func (r CloneResults) Failed() CloneResults {
+	var failed CloneResults
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// vmIDAllocator hands out ascending VMIDs that are free at the time of the allocation.
+type vmIDAllocator struct {
+	mu        sync.Mutex
+	cluster   ClusterService
+	vmIDRange *VMIDRange
+	next      int
+}
+
+func (a *vmIDAllocator) allocate(ctx context.Context) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	vmIDRange := a.vmIDRange
+	if a.next > 0 {
+		vmIDRange = &VMIDRange{Min: a.next}
+		if a.vmIDRange != nil {
+			vmIDRange.Max = a.vmIDRange.Max
+		}
+	}
+	vmID, err := a.cluster.NextVMID(ctx, vmIDRange)
+	if err != nil {
+		return 0, err
+	}
+	a.next = vmID + 1
+	return vmID, nil
+}
+
+// Clone the template count times and wait for the clones. The clones get ascending VMIDs and are spread
+// across options.Nodes and options.Storages. Before the first clone starts, the storages of the template are
+// checked for the clone features, and each storage of options.Storages for VM images on the nodes it is
+// used on. Failed clones are reported in the results; the error is only set if the batch could not be
+// prepared.
+func (s *QemuServiceOp) CloneBatch(ctx context.Context, templateID int, count int, options *CloneBatchOptions) (CloneResults, error) {
+	if count < 1 {
+		return nil, NewArgError("count", "must be at least 1")
+	}
+	if options == nil {
+		options = new(CloneBatchOptions)
+	}
+	var namePattern *template.Template
+	if options.NamePattern != "" {
+		var err error
+		if namePattern, err = template.New("name").Parse(options.NamePattern); err != nil {
+			return nil, NewArgError("options.NamePattern", err.Error())
+		}
+	}
+	base := VMCloneConfig{}
+	if options.CloneConfig != nil {
+		base = *options.CloneConfig
+	}
+	if len(options.Storages) > 0 {
+		if base.Full != nil && !*base.Full {
+			return nil, NewArgError("options.Storages", "requires full clones")
+		}
+		base.Full = Bool(true)
+	}
+
+	location, err := s.client.Cluster.FindVM(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	node := location.Node
+	nodes := options.Nodes
+	if len(nodes) == 0 {
+		nodes = []string{node}
+	}
+	checked := make(map[string]bool)
+	for _, target := range nodes {
+		if checked[target] {
+			continue
+		}
+		checked[target] = true
+		if err := s.checkCloneFeatures(ctx, node, templateID, cloneConfig(base, node, CloneResult{Node: target})); err != nil {
+			return nil, err
+		}
+	}
+
+	allocator := &vmIDAllocator{cluster: s.client.Cluster, vmIDRange: options.VMIDRange}
+	firstIndex := 1
+	if options.FirstIndex != nil {
+		firstIndex = *options.FirstIndex
+	}
+	results := make(CloneResults, count)
+	semaphores := make(map[string]chan struct{})
+	for i := range results {
+		result := &results[i]
+		result.Index = firstIndex + i
+		result.Node = nodes[i%len(nodes)]
+		if len(options.Storages) > 0 {
+			result.Storage = options.Storages[i%len(options.Storages)]
+		}
+		if semaphores[result.Storage] == nil {
+			semaphores[result.Storage] = make(chan struct{}, options.clonesPerStorage())
+		}
+	}
+	if err := s.checkCloneStorages(ctx, results); err != nil {
+		return nil, err
+	}
+	for i := range results {
+		result := &results[i]
+		if result.VMID, err = allocator.allocate(ctx); err != nil {
+			return nil, err
+		}
+		if result.Name, err = cloneName(namePattern, *result); err != nil {
+			return nil, err
+		}
+	}
+
+	var failed int32
+	pending := make([]*Task, count)
+	runParallel(count, count, func(i int) {
+		result := &results[i]
+		semaphore := semaphores[result.Storage]
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return
+		}
+		defer func() { <-semaphore }()
+		if options.Rollback && atomic.LoadInt32(&failed) > 0 {
+			result.Err = ErrCloneNotStarted
+			return
+		}
+
+		start := time.Now()
+		pending[i], result.Err = s.runClone(ctx, node, templateID, base, result, namePattern, allocator, options.Wait)
+		result.Duration = time.Since(start)
+		if result.Err != nil {
+			atomic.AddInt32(&failed, 1)
+		}
+	})
+
+	if options.Rollback && failed > 0 {
+		s.rollbackClones(results, pending, options.Wait)
+	}
+	return results, nil
+}
+
+func (o *CloneBatchOptions) clonesPerStorage() int {
+	if o.ClonesPerStorage <= 0 {
+		return defaultClonesPerStorage
+	}
+	return o.ClonesPerStorage
+}
+
+// checkCloneStorages fails with an ArgError if the storage of a clone can't hold VM images on the node of the
+// clone.
+func (s *QemuServiceOp) checkCloneStorages(ctx context.Context, results CloneResults) error {
+	imageStorages := make(map[string]map[string]bool)
+	for _, result := range results {
+		if result.Storage == "" {
+			continue
+		}
+		storages, ok := imageStorages[result.Node]
+		if !ok {
+			items, err := s.client.API.GetNodesStorage(ctx, result.Node, &GetNodesStorageParams{Content: String("images"), Enabled: Bool(true)})
+			if err != nil {
+				return err
+			}
+			storages = make(map[string]bool)
+			for _, item := range items {
+				storages[item.Storage] = bool(item.Active)
+			}
+			imageStorages[result.Node] = storages
+		}
+		if !storages[result.Storage] {
+			return NewArgError("options.Storages", fmt.Sprintf("%s can't hold VM images on node %s", result.Storage, result.Node))
+		}
+	}
+	return nil
+}
+
+// runClone clones the template as described by result and waits for the clone. A VMID taken concurrently
+// is replaced. If the wait ends before the clone task stopped, the task is returned with the error.
+func (s *QemuServiceOp) runClone(ctx context.Context, node string, templateID int, base VMCloneConfig, result *CloneResult,
+	namePattern *template.Template, allocator *vmIDAllocator, wait *WaitConfig) (*Task, error) {
+	for attempt := 1; ; attempt++ {
+		task, err := s.cloneVM(ctx, node, templateID, result.VMID, cloneConfig(base, node, *result))
+		if _, ok := err.(*VMAlreadyExistsError); ok && attempt < createVMAutoAttempts {
+			log.Printf("[DEBUG] VMID %d was taken concurrently, retrying (attempt %d)\n", result.VMID, attempt)
+			if result.VMID, err = allocator.allocate(ctx); err != nil {
+				return nil, err
+			}
+			if result.Name, err = cloneName(namePattern, *result); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		status, err := task.Wait(ctx, wait)
+		if err != nil && (status == nil || status.Running) {
+			return task, err
+		}
+		return nil, err
+	}
+}
+
+// rollbackClones deletes the clones that succeeded, also if ctx is done already. The clones whose wait
+// ended early are waited for first; if such a clone failed, its result gets the error of the task.
+func (s *QemuServiceOp) rollbackClones(results CloneResults, pending []*Task, wait *WaitConfig) {
+	ctx := context.Background()
+	for i := range results {
+		result := &results[i]
+		if pending[i] != nil {
+			if _, err := pending[i].Wait(ctx, wait); err != nil {
+				if _, ok := err.(*TaskFailedError); ok {
+					result.Err = err
+				} else {
+					result.RollbackErr = err
+				}
+				continue
+			}
+		} else if result.Err != nil {
+			// PVE removes failed clones itself
+			continue
+		}
+		upid, err := s.client.API.DeleteNodesQemuVMID(ctx, result.Node, result.VMID, nil)
+		if err == nil {
+			var task *Task
+			if task, err = s.client.Task(upid); err == nil {
+				_, err = task.Wait(ctx, wait)
+			}
+		}
+		result.RolledBack = err == nil
+		result.RollbackErr = err
+	}
+}
+
+// cloneConfig returns the config of a clone of a template on node.
+func cloneConfig(base VMCloneConfig, node string, result CloneResult) *VMCloneConfig {
+	config := base
+	if result.Name != "" {
+		config.Name = String(result.Name)
+	}
+	if result.Storage != "" {
+		config.Storage = String(result.Storage)
+	}
+	if result.Node != node {
+		config.TargetNode = String(result.Node)
+	} else {
+		config.TargetNode = nil
+	}
+	return &config
+}
+
+// cloneName executes the name pattern for a clone. Without a pattern the name is left to PVE.
+func cloneName(namePattern *template.Template, result CloneResult) (string, error) {
+	if namePattern == nil {
+		return "", nil
+	}
+	var name bytes.Buffer
+	if err := namePattern.Execute(&name, result); err != nil {
+		return "", NewArgError("options.NamePattern", err.Error())
+	}
+	return name.String(), nil
+}
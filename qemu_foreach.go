@@ -0,0 +1,113 @@
+package goproxmox
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Default number of VMs ForEachVM processes at once.
+const defaultForEachVMParallelism = 4
+
+// VMFunc is the work ForEachVM does for a VM of node.
+type VMFunc func(ctx context.Context, node string, vm VM) error
+
+// ForEachVMConfig holds the optional parameters of ForEachVM.
+type ForEachVMConfig struct {
+	// Only process the VMs Filter returns true for. Defaults to all VMs of the node, templates included
+	Filter func(vm VM) bool
+
+	// Number of VMs processed at once. Defaults to 4
+	Parallelism int
+
+	// Don't start further VMs after the first error and cancel the ctx passed to the running ones. The VMs
+	// not started get the error of the canceled ctx as result
+	StopOnError bool
+}
+
+// VMResult is the outcome of a VMFunc for a VM.
+type VMResult struct {
+	VM       VM
+	Err      error
+	Duration time.Duration
+}
+
+// VMResults are the results of ForEachVM in the order of the VMIDs.
+type VMResults []VMResult
+
+// Failed returns the results with an error.
+func (r VMResults) Failed() VMResults {
+	var failed VMResults
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Call fn for the VMs of the node in parallel and report the outcome for every VM. The error is only set if
+// the VMs could not be listed; errors of fn are in the results.
+func (s *QemuServiceOp) ForEachVM(ctx context.Context, node string, fn VMFunc, config *ForEachVMConfig) (VMResults, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	vms, err := s.GetVMList(node)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := defaultForEachVMParallelism
+	var results VMResults
+	for _, vm := range vms {
+		if config == nil || config.Filter == nil || config.Filter(vm) {
+			results = append(results, VMResult{VM: vm})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].VM.VMID < results[j].VM.VMID })
+	if config != nil && config.Parallelism > 0 {
+		parallelism = config.Parallelism
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	runParallel(len(results), parallelism, func(i int) {
+		result := &results[i]
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			return
+		}
+		start := time.Now()
+		result.Err = fn(ctx, node, result.VM)
+		result.Duration = time.Since(start)
+		if result.Err != nil && config != nil && config.StopOnError {
+			cancel()
+		}
+	})
+	return results, nil
+}
+
+// runParallel calls fn for the indexes 0 to count-1, at most parallelism calls at once, and returns once
+// all calls returned.
+func runParallel(count, parallelism int, fn func(i int)) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < parallelism && worker < count; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
@@ -0,0 +1,105 @@
+package goproxmox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestRunParallel(t *testing.T) {
+	tests := []struct {
+		count, parallelism int
+		maxRunning         int
+	}{
+		{count: 0, parallelism: 4, maxRunning: 0},
+		{count: 1, parallelism: 4, maxRunning: 1},
+		{count: 10, parallelism: 1, maxRunning: 1},
+		{count: 10, parallelism: 3, maxRunning: 3},
+		{count: 3, parallelism: 10, maxRunning: 3},
+		{count: 5, parallelism: 0, maxRunning: 1},
+	}
+
+	for _, test := range tests {
+		var mu sync.Mutex
+		calls := make([]int, test.count)
+		running, maxRunning := 0, 0
+		release := make(chan struct{})
+		started := make(chan struct{}, test.count)
+		go func() {
+			// let the calls pile up before they return
+			for i := 0; i < test.count; i++ {
+				<-started
+				release <- struct{}{}
+			}
+		}()
+		runParallel(test.count, test.parallelism, func(i int) {
+			mu.Lock()
+			calls[i]++
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+			started <- struct{}{}
+			<-release
+			mu.Lock()
+			running--
+			mu.Unlock()
+		})
+
+		for i, n := range calls {
+			if n != 1 {
+				t.Errorf("count %d, parallelism %d: index %d called %d times", test.count, test.parallelism, i, n)
+			}
+		}
+		if maxRunning > test.maxRunning {
+			t.Errorf("count %d, parallelism %d: %d calls at once, want at most %d", test.count, test.parallelism, maxRunning, test.maxRunning)
+		}
+	}
+}
+
+func TestForEachVMStopOnError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api2/json/nodes/pve1/qemu", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"vmid":103},{"vmid":100},{"vmid":102},{"vmid":101},{"vmid":104}]}`)
+	})
+	client, teardown := setup(mux)
+	defer teardown()
+
+	errFailed := errors.New("failed")
+	fn := func(ctx context.Context, node string, vm VM) error {
+		if vm.VMID == 102 {
+			return errFailed
+		}
+		return nil
+	}
+	tests := []struct {
+		stopOnError bool
+		errs        []error
+	}{
+		{stopOnError: false, errs: []error{nil, nil, errFailed, nil, nil}},
+		{stopOnError: true, errs: []error{nil, nil, errFailed, context.Canceled, context.Canceled}},
+	}
+
+	for _, test := range tests {
+		results, err := client.VMs.ForEachVM(context.Background(), "pve1", fn, &ForEachVMConfig{Parallelism: 1, StopOnError: test.stopOnError})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != len(test.errs) {
+			t.Fatalf("StopOnError %v: %d results, want %d", test.stopOnError, len(results), len(test.errs))
+		}
+		for i, result := range results {
+			if result.VM.VMID != 100+i || result.Err != test.errs[i] {
+				t.Errorf("StopOnError %v: result %d is VM %d with %v, want VM %d with %v",
+					test.stopOnError, i, result.VM.VMID, result.Err, 100+i, test.errs[i])
+			}
+		}
+		if failed := results.Failed(); len(failed) == 0 || failed[0].VM.VMID != 102 {
+			t.Errorf("StopOnError %v: failed = %+v", test.stopOnError, failed)
+		}
+	}
+}
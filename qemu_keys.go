@@ -0,0 +1,186 @@
+package goproxmox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Default pause between two keys typed by TypeText.
+const defaultKeyDelay = 50 * time.Millisecond
+
+// TypeTextConfig holds the optional parameters of TypeText.
+type TypeTextConfig struct {
+	// Keyboard layout of the guest: en-us (the default), en-gb, de or fr
+	Layout KeyboardLayout
+
+	// Pause between two keys. Defaults to 50ms, slow installers may need more
+	KeyDelay time.Duration
+}
+
+// keyMap maps a character to the keys (qemu monitor encoding) typing it. Dead keys are followed by "spc",
+// separated by a space.
+type keyMap map[rune]string
+
+// typeableLayouts are the keyboard layouts TypeText knows.
+var typeableLayouts = map[KeyboardLayout]keyMap{
+	KeyboardLayout_EN_US: enUSKeys(),
+	KeyboardLayout_EN_GB: enGBKeys(),
+	KeyboardLayout_DE:    deKeys(),
+	KeyboardLayout_FR:    frKeys(),
+}
+
+// addLetters maps the lower and upper case letters of a keyboard. keys names the keys of a-z, in that order.
+func (m keyMap) addLetters(keys []string) {
+	for i, key := range keys {
+		letter := rune('a' + i)
+		m[letter] = key
+		m[unicode.ToUpper(letter)] = "shift-" + key
+	}
+}
+
+func (m keyMap) add(keys map[rune]string) keyMap {
+	for r, key := range keys {
+		m[r] = key
+	}
+	return m
+}
+
+func qwertyLetters() []string {
+	letters := make([]string, 26)
+	for i := range letters {
+		letters[i] = string(rune('a' + i))
+	}
+	return letters
+}
+
+func enUSKeys() keyMap {
+	m := keyMap{
+		' ': "spc", '\n': "ret", '\t': "tab",
+		'0': "0", '1': "1", '2': "2", '3': "3", '4': "4", '5': "5", '6': "6", '7': "7", '8': "8", '9': "9",
+		')': "shift-0", '!': "shift-1", '@': "shift-2", '#': "shift-3", '$': "shift-4",
+		'%': "shift-5", '^': "shift-6", '&': "shift-7", '*': "shift-8", '(': "shift-9",
+		'-': "minus", '_': "shift-minus", '=': "equal", '+': "shift-equal",
+		'[': "bracket_left", '{': "shift-bracket_left", ']': "bracket_right", '}': "shift-bracket_right",
+		'\\': "backslash", '|': "shift-backslash", ';': "semicolon", ':': "shift-semicolon",
+		'\'': "apostrophe", '"': "shift-apostrophe", '`': "grave_accent", '~': "shift-grave_accent",
+		',': "comma", '<': "shift-comma", '.': "dot", '>': "shift-dot", '/': "slash", '?': "shift-slash",
+	}
+	m.addLetters(qwertyLetters())
+	return m
+}
+
+func enGBKeys() keyMap {
+	return enUSKeys().add(keyMap{
+		'"': "shift-2", '£': "shift-3", '@': "shift-apostrophe",
+		'#': "backslash", '~': "shift-backslash",
+		'\\': "less", '|': "shift-less", '¬': "shift-grave_accent",
+	})
+}
+
+func deKeys() keyMap {
+	m := keyMap{
+		' ': "spc", '\n': "ret", '\t': "tab",
+		'0': "0", '1': "1", '2': "2", '3': "3", '4': "4", '5': "5", '6': "6", '7': "7", '8': "8", '9': "9",
+		'=': "shift-0", '!': "shift-1", '"': "shift-2", '§': "shift-3", '$': "shift-4",
+		'%': "shift-5", '&': "shift-6", '/': "shift-7", '(': "shift-8", ')': "shift-9",
+		'ß': "minus", '?': "shift-minus", '\\': "alt_r-minus",
+		'^': "grave_accent spc", '°': "shift-grave_accent", '`': "shift-equal spc",
+		'ü': "bracket_left", 'Ü': "shift-bracket_left",
+		'+': "bracket_right", '*': "shift-bracket_right", '~': "alt_r-bracket_right",
+		'ö': "semicolon", 'Ö': "shift-semicolon", 'ä': "apostrophe", 'Ä': "shift-apostrophe",
+		'#': "backslash", '\'': "shift-backslash",
+		',': "comma", ';': "shift-comma", '.': "dot", ':': "shift-dot", '-': "slash", '_': "shift-slash",
+		'<': "less", '>': "shift-less", '|': "alt_r-less",
+		'@': "alt_r-q", '€': "alt_r-e", 'µ': "alt_r-m",
+		'{': "alt_r-7", '[': "alt_r-8", ']': "alt_r-9", '}': "alt_r-0",
+	}
+	letters := qwertyLetters()
+	letters['y'-'a'], letters['z'-'a'] = "z", "y"
+	m.addLetters(letters)
+	return m
+}
+
+func frKeys() keyMap {
+	m := keyMap{
+		' ': "spc", '\n': "ret", '\t': "tab",
+		'à': "0", '&': "1", 'é': "2", '"': "3", '\'': "4", '(': "5", '-': "6", 'è': "7", '_': "8", 'ç': "9",
+		'0': "shift-0", '1': "shift-1", '2': "shift-2", '3': "shift-3", '4': "shift-4",
+		'5': "shift-5", '6': "shift-6", '7': "shift-7", '8': "shift-8", '9': "shift-9",
+		')': "minus", '°': "shift-minus", '=': "equal", '+': "shift-equal",
+		'~': "alt_r-2", '#': "alt_r-3", '{': "alt_r-4", '[': "alt_r-5", '|': "alt_r-6",
+		'`': "alt_r-7", '\\': "alt_r-8", '^': "alt_r-9", '@': "alt_r-0", ']': "alt_r-minus", '}': "alt_r-equal",
+		'€': "alt_r-e", '¨': "shift-bracket_left spc",
+		'$': "bracket_right", '£': "shift-bracket_right",
+		'ù': "apostrophe", '%': "shift-apostrophe", '*': "backslash", 'µ': "shift-backslash",
+		',': "m", '?': "shift-m", ';': "comma", '.': "shift-comma", ':': "dot", '/': "shift-dot",
+		'!': "slash", '§': "shift-slash", '<': "less", '>': "shift-less", '²': "grave_accent",
+	}
+	letters := qwertyLetters()
+	letters['a'-'a'], letters['q'-'a'] = "q", "a"
+	letters['z'-'a'], letters['w'-'a'] = "w", "z"
+	letters['m'-'a'] = "semicolon"
+	m.addLetters(letters)
+	return m
+}
+
+// Send a key event to the VM. key is in qemu monitor encoding, e.g. "ret", "shift-a" or "ctrl-alt-delete".
+func (s *QemuServiceOp) SendKey(ctx context.Context, node string, vmID int, key string) error {
+	if key == "" {
+		return NewArgError("key", "cannot be empty")
+	}
+	return s.client.API.PutNodesQemuSendKey(ctx, node, vmID, key, nil)
+}
+
+// Type text into the VM by sending a key event for every character, e.g. to answer the prompts of an
+// installer. The text is checked against the keyboard layout before the first key is sent. Only single
+// characters are typed, use SendKey for shortcuts. The supported layouts are en-us, en-gb, de and fr, other
+// layouts fail with an ArgError.
+func (s *QemuServiceOp) TypeText(ctx context.Context, node string, vmID int, text string, config *TypeTextConfig) error {
+	layout, delay := KeyboardLayout_EN_US, defaultKeyDelay
+	if config != nil {
+		if config.Layout != "" {
+			layout = config.Layout
+		}
+		if config.KeyDelay > 0 {
+			delay = config.KeyDelay
+		}
+	}
+	keys, err := textToKeys(text, layout)
+	if err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+		if err := s.SendKey(ctx, node, vmID, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// textToKeys converts text to the keys typing it with the given keyboard layout.
+func textToKeys(text string, layout KeyboardLayout) ([]string, error) {
+	keyMap, ok := typeableLayouts[layout]
+	if !ok {
+		return nil, NewArgError("layout", fmt.Sprintf("%s is not supported for typing, use en-us, en-gb, de or fr", layout))
+	}
+	keys := make([]string, 0, len(text))
+	for _, r := range strings.Replace(text, "\r\n", "\n", -1) {
+		key, ok := keyMap[r]
+		if !ok {
+			return nil, NewArgError("text", fmt.Sprintf("%q cannot be typed with layout %s", r, layout))
+		}
+		keys = append(keys, strings.Fields(key)...)
+	}
+	return keys, nil
+}
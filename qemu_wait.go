@@ -0,0 +1,151 @@
+package goproxmox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VMStatusPredicate reports whether a VM reached the state WaitForVMStatus waits for. Besides the current
+// status it gets the client and the VM, so that it can query more than the status (see VMAgentReady).
+// An error ends the wait.
+type VMStatusPredicate func(ctx context.Context, client *Client, node string, vmID int, status *VMStatus) (bool, error)
+
+var (
+	// VMRunning is satisfied when the QEMU process is running. The guest may still be booting or paused.
+	VMRunning VMStatusPredicate = vmStatusIs("running")
+
+	// VMStopped is satisfied when the QEMU process is not running.
+	VMStopped VMStatusPredicate = vmStatusIs(vmStatusStopped)
+
+	// VMPaused is satisfied when the VM is running but paused.
+	VMPaused VMStatusPredicate = func(ctx context.Context, client *Client, node string, vmID int, status *VMStatus) (bool, error) {
+		return status.Status == "running" && status.QMPstatus == "paused", nil
+	}
+
+	// VMUnlocked is satisfied when no config lock (clone, migrate, backup, ...) is held on the VM.
+	VMUnlocked VMStatusPredicate = func(ctx context.Context, client *Client, node string, vmID int, status *VMStatus) (bool, error) {
+		return status.Lock == "", nil
+	}
+
+	// VMAgentReady is satisfied when the VM is running and the QEMU guest agent answers a ping.
+	VMAgentReady VMStatusPredicate = func(ctx context.Context, client *Client, node string, vmID int, status *VMStatus) (bool, error) {
+		if status.Status != "running" {
+			return false, nil
+		}
+		return client.VMs.PingAgent(ctx, node, vmID) == nil, nil
+	}
+)
+
+func vmStatusIs(want string) VMStatusPredicate {
+	return func(ctx context.Context, client *Client, node string, vmID int, status *VMStatus) (bool, error) {
+		return status.Status == want, nil
+	}
+}
+
+// WaitConfig controls how WaitForVMStatus and Task.Wait poll. The interval starts at Interval and is
+// multiplied by Multiplier after every poll up to MaxInterval.
+type WaitConfig struct {
+	Interval    time.Duration // Defaults to 500ms
+	MaxInterval time.Duration // Defaults to 5s
+	Multiplier  float64       // Defaults to 1.5
+
+	// Give up after Timeout with a WaitTimeoutError (TaskTimeoutError). Without a timeout only ctx ends
+	// the wait.
+	Timeout time.Duration
+}
+
+func (c *WaitConfig) withDefaults() WaitConfig {
+	config := WaitConfig{
+		Interval:    500 * time.Millisecond,
+		MaxInterval: 5 * time.Second,
+		Multiplier:  1.5,
+	}
+	if c == nil {
+		return config
+	}
+	if c.Interval > 0 {
+		config.Interval = c.Interval
+	}
+	if c.MaxInterval > 0 {
+		config.MaxInterval = c.MaxInterval
+	}
+	if c.Multiplier >= 1 {
+		config.Multiplier = c.Multiplier
+	}
+	config.Timeout = c.Timeout
+	return config
+}
+
+// WaitTimeoutError is returned when the timeout of WaitConfig passes before the VM reached the awaited state.
+type WaitTimeoutError struct {
+	VMID    int
+	Timeout time.Duration
+
+	// The last status seen
+	Status *VMStatus
+}
+
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("VM %d did not reach the awaited state within %s", e.VMID, e.Timeout)
+}
+
+// Poll the status of the VM until predicate is satisfied and return the final status. The wait ends with a
+// WaitTimeoutError after config.Timeout, or with the error of ctx when ctx is done.
+func (s *QemuServiceOp) WaitForVMStatus(ctx context.Context, node string, vmID int, predicate VMStatusPredicate, config *WaitConfig) (*VMStatus, error) {
+	var status *VMStatus
+	err := poll(ctx, config, func() (done bool, err error) {
+		if status, err = s.getVMCurrentStatus(ctx, node, vmID); err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			return false, err
+		}
+		return predicate(ctx, s.client, node, vmID, status)
+	})
+	if err == errPollTimeout {
+		return status, &WaitTimeoutError{VMID: vmID, Timeout: config.withDefaults().Timeout, Status: status}
+	}
+	return status, err
+}
+
+// errPollTimeout is returned by poll when the timeout of its WaitConfig passed.
+var errPollTimeout = errors.New("poll timeout")
+
+// poll calls check with the intervals of config until it reports done or fails, and returns the error of
+// check. It fails with errPollTimeout after config.Timeout, and with the error of ctx when ctx is done.
+func poll(ctx context.Context, config *WaitConfig, check func() (bool, error)) error {
+	waitConfig := config.withDefaults()
+	var deadline <-chan time.Time
+	if waitConfig.Timeout > 0 {
+		timer := time.NewTimer(waitConfig.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	interval := waitConfig.Interval
+	for {
+		if done, err := check(); err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return errPollTimeout
+		case <-time.After(interval):
+		}
+		interval = time.Duration(float64(interval) * waitConfig.Multiplier)
+		if interval > waitConfig.MaxInterval {
+			interval = waitConfig.MaxInterval
+		}
+	}
+}
+
+// Ping the QEMU guest agent. It fails if the agent is not enabled or does not answer.
+func (s *QemuServiceOp) PingAgent(ctx context.Context, node string, vmID int) error {
+	_, err := s.client.API.PostNodesQemuAgentPing(ctx, node, vmID)
+	return err
+}
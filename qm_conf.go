@@ -0,0 +1,297 @@
+package goproxmox
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	confSectionPending   = "PENDING"
+	confSectionCloudInit = "special:cloudinit"
+)
+
+var (
+	confPendingRegexp   = regexp.MustCompile(`(?i)^\[PENDING\]\s*$`)
+	confCloudInitRegexp = regexp.MustCompile(`(?i)^\[special:cloudinit\]\s*$`)
+	confSnapshotRegexp  = regexp.MustCompile(`(?i)^\[([a-z][a-z0-9_\-]+)\]\s*$`)
+	confCommentRegexp   = regexp.MustCompile(`^#(.*)$`)
+	confOptionRegexp    = regexp.MustCompile(`^([a-z][a-z_]*\d*):\s*(.*?)\s*$`)
+	confEscapedRegexp   = regexp.MustCompile(`%[0-9a-fA-F]{2}`)
+)
+
+// Keys that only exist in API requests and never end up in a qemu-server .conf file.
+var confIgnoredParameters = map[string]bool{
+	parameterArchive:     true,
+	parameterDelete:      true,
+	parameterDescription: true,
+	parameterDigest:      true,
+	parameterForce:       true,
+	parameterPool:        true,
+	parameterRevert:      true,
+	parameterSkipLock:    true,
+	parameterStorage:     true,
+	parameterUnique:      true,
+	parameterVMID:        true,
+}
+
+// QemuServerConf is the content of a qemu-server configuration file as stored in /etc/pve/qemu-server/<vmid>.conf
+// or in the qemu-server.conf of a vzdump archive.
+type QemuServerConf struct {
+	// The current configuration. Its Digest is the SHA1 checksum of the parsed file, the same value the API reports.
+	Config *VMConfig
+
+	// Changes that are not applied yet ([PENDING] section). Options marked for deletion are listed in Pending.Delete.
+	Pending *VMConfig
+
+	// The cloud-init state of the last generated image ([special:cloudinit] section).
+	CloudInit map[string]string
+
+	// Snapshots keyed by their name. Snapshot metadata (parent, snaptime, vmstate, ...) is kept in Unknown.
+	Snapshots map[string]*VMConfig
+}
+
+// ParseQemuServerConf reads a qemu-server configuration file.
+func ParseQemuServerConf(r io.Reader) (*QemuServerConf, error) {
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := []string{""}
+	values := map[string]map[string]interface{}{"": {}}
+	descriptions := make(map[string]*bytes.Buffer)
+	section := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 64*1024), len(raw)+1)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		nextSection := ""
+		switch {
+		case confPendingRegexp.MatchString(line):
+			nextSection = confSectionPending
+		case confCloudInitRegexp.MatchString(line):
+			nextSection = confSectionCloudInit
+		case confSnapshotRegexp.MatchString(line):
+			nextSection = confSnapshotRegexp.FindStringSubmatch(line)[1]
+		}
+		if nextSection != "" {
+			if _, ok := values[nextSection]; ok {
+				return nil, fmt.Errorf("line %d: duplicate section [%s]", lineNumber, nextSection)
+			}
+			section = nextSection
+			sections = append(sections, section)
+			values[section] = make(map[string]interface{})
+			continue
+		}
+
+		if matchResults := confCommentRegexp.FindStringSubmatch(line); matchResults != nil {
+			if descriptions[section] == nil {
+				descriptions[section] = new(bytes.Buffer)
+			}
+			descriptions[section].WriteString(decodeConfText(matchResults[1]))
+			descriptions[section].WriteString("\n")
+			continue
+		}
+
+		matchResults := confOptionRegexp.FindStringSubmatch(line)
+		if matchResults == nil {
+			return nil, fmt.Errorf("line %d: unable to parse %q", lineNumber, line)
+		}
+		key, value := matchResults[1], matchResults[2]
+		if key == parameterDescription {
+			// an inline description is appended to the comments
+			if descriptions[section] == nil {
+				descriptions[section] = new(bytes.Buffer)
+			}
+			descriptions[section].WriteString(decodeConfText(value))
+			continue
+		}
+		values[section][key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	conf := new(QemuServerConf)
+	for _, section := range sections {
+		data := values[section]
+		if description, ok := descriptions[section]; ok {
+			data[parameterDescription] = strings.TrimSuffix(description.String(), "\n")
+		}
+
+		switch section {
+		case "":
+			conf.Config = newVMConfigFromConf(data)
+			digest := sha1.Sum(raw)
+			conf.Config.Digest = String(hex.EncodeToString(digest[:]))
+		case confSectionPending:
+			conf.Pending = newVMConfigFromConf(data)
+		case confSectionCloudInit:
+			conf.CloudInit = make(map[string]string)
+			for k, v := range data {
+				conf.CloudInit[k] = interfaceToString(v)
+			}
+		default:
+			if conf.Snapshots == nil {
+				conf.Snapshots = make(map[string]*VMConfig)
+			}
+			conf.Snapshots[section] = newVMConfigFromConf(data)
+		}
+	}
+
+	return conf, nil
+}
+
+// newVMConfigFromConf builds a VMConfig from the options of one section.
+// A "delete" option (only found in the [PENDING] section) is stored in Delete.
+func newVMConfigFromConf(data map[string]interface{}) *VMConfig {
+	deleteValue, hasDelete := data[parameterDelete]
+	delete(data, parameterDelete)
+
+	config := NewVMConfigFromMap(data)
+	if hasDelete {
+		for _, key := range strings.FieldsFunc(interfaceToString(deleteValue), func(r rune) bool {
+			return r == ',' || r == ';' || r == ' '
+		}) {
+			config.Delete = append(config.Delete, key)
+		}
+	}
+	return config
+}
+
+// Write writes the configuration in the qemu-server .conf format.
+// Sections are written in the same order as Proxmox does: pending changes, cloud-init state and snapshots sorted by name.
+func (c *QemuServerConf) Write(w io.Writer) error {
+	if c.Config != nil {
+		if err := c.Config.writeConf(w, false); err != nil {
+			return err
+		}
+	}
+	if c.Pending != nil {
+		if _, err := fmt.Fprintf(w, "\n[%s]\n", confSectionPending); err != nil {
+			return err
+		}
+		if err := c.Pending.writeConf(w, true); err != nil {
+			return err
+		}
+	}
+	if len(c.CloudInit) > 0 {
+		if _, err := fmt.Fprintf(w, "\n[%s]\n", confSectionCloudInit); err != nil {
+			return err
+		}
+		if err := writeConfOptions(w, c.CloudInit); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, 0, len(c.Snapshots))
+	for name := range c.Snapshots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !confSnapshotRegexp.MatchString("[" + name + "]") {
+			return NewArgError("snapshots", fmt.Sprintf("invalid snapshot name %q", name))
+		}
+		if _, err := fmt.Fprintf(w, "\n[%s]\n", name); err != nil {
+			return err
+		}
+		if err := c.Snapshots[name].writeConf(w, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteConf writes the configuration as the main section of a qemu-server .conf file.
+// The description is written as '#' comments, all other options sorted by their name.
+func (c *VMConfig) WriteConf(w io.Writer) error {
+	return c.writeConf(w, false)
+}
+
+func (c *VMConfig) writeConf(w io.Writer, withDelete bool) error {
+	configMap, err := c.GetOptionsMap()
+	if err != nil {
+		return err
+	}
+	for key := range configMap {
+		if confIgnoredParameters[key] {
+			delete(configMap, key)
+		}
+	}
+	for key, value := range c.Unknown {
+		if _, ok := configMap[key]; !ok {
+			configMap[key] = value
+		}
+	}
+	if withDelete && len(c.Delete) > 0 {
+		configMap[parameterDelete] = strings.Join(c.Delete, ",")
+	}
+
+	if c.Description != nil {
+		lines := []string{""}
+		if description := StringValue(c.Description); description != "" {
+			lines = strings.Split(description, "\n")
+		}
+		for _, line := range lines {
+			if _, err := io.WriteString(w, "#"+encodeConfText(line)+"\n"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writeConfOptions(w, configMap)
+}
+
+func writeConfOptions(w io.Writer, options map[string]string) error {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, err := fmt.Fprintf(w, "%s: %s\n", key, options[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeConfText escapes control and hi-bit characters and ':' like PVE::Tools::encode_text.
+func encodeConfText(text string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(text); i++ {
+		b := text[i]
+		if (b >= 0x20 && b <= 0x39) || (b >= 0x3b && b <= 0x7e) {
+			buf.WriteByte(b)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+	return buf.String()
+}
+
+// decodeConfText reverses encodeConfText.
+func decodeConfText(text string) string {
+	return confEscapedRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		b, _ := strconv.ParseUint(s[1:], 16, 8)
+		return string([]byte{byte(b)})
+	})
+}
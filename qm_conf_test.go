@@ -0,0 +1,162 @@
+package goproxmox
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQemuServerConfRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		conf string
+	}{
+		{name: "options", conf: "cores: 2\nmemory: 2048\nname: web\nsockets: 1\n"},
+		{name: "description", conf: "#first line\n#second%3A with a colon\n#\n#%09tab\ncores: 1\n"},
+		{name: "empty description", conf: "#\nname: web\n"},
+		{name: "unknown options", conf: "cores: 1\nmeta: creation-qemu=8.1.5,ctime=1700000000\n"},
+		{name: "pending", conf: "cores: 1\n\n[PENDING]\ncores: 4\ndelete: balloon,onboot\n"},
+		{name: "cloud-init", conf: "cores: 1\n\n[special:cloudinit]\nciuser: admin\nipconfig0: ip=dhcp\n"},
+		{name: "snapshots", conf: "cores: 1\nparent: second\n\n[first]\ncores: 1\nsnaptime: 1700000000\n\n" +
+			"[second]\n#before the upgrade\ncores: 2\nparent: first\nsnaptime: 1700000100\nvmstate: local:state-second\n"},
+		{name: "all sections", conf: "#desc\ncores: 1\nparent: snap\n\n[PENDING]\nmemory: 4096\n\n" +
+			"[special:cloudinit]\nname: web\n\n[snap]\ncores: 1\nsnaptime: 1700000000\n"},
+	}
+
+	for _, test := range tests {
+		conf, err := ParseQemuServerConf(strings.NewReader(test.conf))
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		var buf bytes.Buffer
+		if err := conf.Write(&buf); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if buf.String() != test.conf {
+			t.Errorf("%s: %q written as %q", test.name, test.conf, buf.String())
+		}
+	}
+}
+
+func TestParseQemuServerConf(t *testing.T) {
+	raw := "#web server\n#port 80%3A443\ncores: 2\nparent: before-upgrade\n" +
+		"\n[PENDING]\ncores: 4\ndelete: onboot\n" +
+		"\n[special:cloudinit]\nciuser: admin\n" +
+		"\n[before-upgrade]\ncores: 1\nsnaptime: 1700000000\n"
+	conf, err := ParseQemuServerConf(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha1.Sum([]byte(raw))
+	if d := StringValue(conf.Config.Digest); d != hex.EncodeToString(digest[:]) {
+		t.Errorf("digest = %q, want the SHA1 of the file", d)
+	}
+	if d := StringValue(conf.Config.Description); d != "web server\nport 80:443" {
+		t.Errorf("description = %q", d)
+	}
+	if IntValue(conf.Config.Cores) != 2 || conf.Config.Unknown["parent"] != "before-upgrade" {
+		t.Errorf("config = %+v", conf.Config)
+	}
+	if conf.Pending == nil || IntValue(conf.Pending.Cores) != 4 || !reflect.DeepEqual(conf.Pending.Delete, []string{"onboot"}) {
+		t.Errorf("pending = %+v", conf.Pending)
+	}
+	if !reflect.DeepEqual(conf.CloudInit, map[string]string{"ciuser": "admin"}) {
+		t.Errorf("cloud-init = %v", conf.CloudInit)
+	}
+	snapshot := conf.Snapshots["before-upgrade"]
+	if len(conf.Snapshots) != 1 || snapshot == nil || IntValue(snapshot.Cores) != 1 || snapshot.Unknown["snaptime"] != "1700000000" {
+		t.Errorf("snapshots = %+v", conf.Snapshots)
+	}
+	if snapshot != nil && snapshot.Digest != nil {
+		t.Errorf("snapshot digest = %q, want none", *snapshot.Digest)
+	}
+}
+
+func TestParseQemuServerConfErrors(t *testing.T) {
+	tests := []string{
+		"cores: 1\n[snap]\ncores: 1\n[snap]\ncores: 2\n",
+		"cores 1\n",
+		"[PENDING]\n[pending]\n",
+	}
+
+	for _, conf := range tests {
+		if _, err := ParseQemuServerConf(strings.NewReader(conf)); err == nil {
+			t.Errorf("%q: no error", conf)
+		}
+	}
+}
+
+func TestQemuAgentRoundTrip(t *testing.T) {
+	tests := []struct {
+		conf    string
+		enabled bool
+		fstrim  *bool
+	}{
+		{conf: "agent: 0\n", enabled: false},
+		{conf: "agent: 1\n", enabled: true},
+		{conf: "agent: 1,fstrim_cloned_disks=1\n", enabled: true, fstrim: Bool(true)},
+		{conf: "agent: 1,freeze-fs-on-backup=0,fstrim_cloned_disks=0,type=isa\n", enabled: true, fstrim: Bool(false)},
+	}
+
+	for _, test := range tests {
+		conf, err := ParseQemuServerConf(strings.NewReader(test.conf))
+		if err != nil {
+			t.Fatalf("%q: %v", test.conf, err)
+		}
+		agent := conf.Config.QemuAgent
+		if agent == nil || BoolValue(agent.Enabled) != test.enabled {
+			t.Errorf("%q: agent = %+v, want enabled %v", test.conf, agent, test.enabled)
+			continue
+		}
+		if (agent.FstrimClonedDisks == nil) != (test.fstrim == nil) ||
+			(test.fstrim != nil && *agent.FstrimClonedDisks != *test.fstrim) {
+			t.Errorf("%q: fstrim_cloned_disks = %v, want %v", test.conf, agent.FstrimClonedDisks, test.fstrim)
+		}
+
+		var buf bytes.Buffer
+		if err := conf.Config.WriteConf(&buf); err != nil {
+			t.Fatalf("%q: %v", test.conf, err)
+		}
+		if buf.String() != test.conf {
+			t.Errorf("%q: written as %q", test.conf, buf.String())
+		}
+
+		data, err := json.Marshal(conf.Config)
+		if err != nil {
+			t.Fatalf("%q: %v", test.conf, err)
+		}
+		decoded := new(VMConfig)
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("%q: %s: %v", test.conf, data, err)
+		}
+		diff, err := DiffVMConfig(conf.Config, decoded)
+		if err != nil {
+			t.Fatalf("%q: %v", test.conf, err)
+		}
+		if !diff.IsEmpty() {
+			t.Errorf("%q: JSON %s differs:\n%s", test.conf, data, diff)
+		}
+	}
+}
+
+func TestQemuAgentUnmarshalJSONBool(t *testing.T) {
+	config := new(VMConfig)
+	if err := json.Unmarshal([]byte(`{"agent": true}`), config); err != nil {
+		t.Fatal(err)
+	}
+	if config.QemuAgent == nil || !BoolValue(config.QemuAgent.Enabled) {
+		t.Fatalf("agent = %+v, want enabled", config.QemuAgent)
+	}
+	options, err := config.GetOptionsMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options[parameterQemuAgent] != "1" {
+		t.Errorf("agent option = %q, want \"1\"", options[parameterQemuAgent])
+	}
+}
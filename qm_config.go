@@ -3,10 +3,12 @@ package goproxmox
 import (
 	"errors"
 	"fmt"
+	"log"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
-	"log"
+	"strings"
 )
 
 const (
@@ -14,60 +16,82 @@ const (
 	parameterQemuAgent                 = "agent"
 	parameterArchive                   = "archive"
 	parameterArgs                      = "args"
+	parameterAudio                     = "audio0"
 	parameterAutoStart                 = "autostart"
 	parameterBalloon                   = "balloon"
 	parameterBios                      = "bios"
 	parameterBootOrder                 = "boot"
 	parameterBootDisk                  = "bootdisk"
 	parameterCDROM                     = "cdrom"
+	parameterCICustom                  = "cicustom"
+	parameterCIPassword                = "cipassword"
+	parameterCIType                    = "citype"
+	parameterCIUpgrade                 = "ciupgrade"
+	parameterCIUser                    = "ciuser"
 	parameterCores                     = "cores"
 	parameterCPU                       = "cpu"
 	parameterCPULimit                  = "cpulimit"
 	parameterCPUUnits                  = "cpuunits"
+	parameterDelete                    = "delete"
 	parameterDescription               = "description"
+	parameterDigest                    = "digest"
+	parameterEFIDisk                   = "efidisk0"
 	parameterForce                     = "force"
 	parameterFreeze                    = "freeze"
 	parameterHostPCI                   = "hostpci"
 	parameterHotPlug                   = "hotplug"
 	parameterHugePages                 = "hugepages"
 	parameterIDEDevices                = "ide"
+	parameterIPConfigs                 = "ipconfig"
+	parameterIVShmem                   = "ivshmem"
 	parameterKeyboardLayout            = "keyboard"
 	parameterKVMHardwareVirtualization = "kvm"
 	parameterLocalTime                 = "localtime"
 	parameterLock                      = "lock"
 	parameterMachineType               = "machine"
 	parameterMemory                    = "memory"
+	parameterMeta                      = "meta"
 	parameterMigrateDowntime           = "migrate_downtime"
 	parameterMigrateSpeed              = "migrate_speed"
 	parameterName                      = "name"
+	parameterNameserver                = "nameserver"
 	parameterNetworkDevices            = "net"
 	parameterNUMA                      = "numa"
 	parameterNUMATopologies            = "numa"
 	parameterStartAtBoot               = "onboot"
 	parameterOSType                    = "ostype"
 	parameterParallelDevices           = "parallel"
+	parameterParent                    = "parent"
 	parameterPool                      = "pool"
 	parameterProtection                = "protection"
 	parameterReboot                    = "reboot"
+	parameterRevert                    = "revert"
+	parameterRNG                       = "rng0"
 	parameterSATADevices               = "sata"
 	parameterSCSIDevices               = "scsi"
 	parameterSCSIControllerType        = "scsihw"
+	parameterSearchDomain              = "searchdomain"
 	parameterSerialDevices             = "serial"
+	parameterSkipLock                  = "skiplock"
 	parameterMemoryShares              = "shares"
 	parameterSMBIOS1                   = "smbios1"
 	parameterSMP                       = "smp"
 	parameterSockets                   = "sockets"
+	parameterSSHKeys                   = "sshkeys"
+	parameterSpiceEnhancements         = "spice_enhancements"
 	parameterStartDate                 = "startdate"
 	parameterStartup                   = "startup"
 	parameterStorage                   = "storage"
 	parameterTablet                    = "tablet"
 	parameterTDF                       = "tdf"
 	parameterTemplate                  = "template"
+	parameterTPMState                  = "tpmstate0"
 	parameterUnique                    = "unique"
 	parameterUSBDevices                = "usb"
 	parameterVCPUs                     = "vcpus"
 	parameterVGA                       = "vga"
 	parameterVirtIODevices             = "virtio"
+	parameterVMGenID                   = "vmgenid"
 	parameterVMID                      = "vmid"
 	parameterWatchdog                  = "watchdog"
 )
@@ -77,23 +101,33 @@ var parameterMapping = map[*regexp.Regexp]string{
 	regexp.MustCompile(parameterQemuAgent):                 "QemuAgent",
 	regexp.MustCompile(parameterArchive):                   "Archive",
 	regexp.MustCompile(parameterArgs):                      "Args",
+	regexp.MustCompile(parameterAudio):                     "Audio",
 	regexp.MustCompile(parameterAutoStart):                 "AutoStart",
 	regexp.MustCompile(parameterBalloon):                   "Balloon",
 	regexp.MustCompile(parameterBios):                      "Bios",
 	regexp.MustCompile(parameterBootOrder):                 "BootOrder",
 	regexp.MustCompile(parameterBootDisk):                  "BootDisk",
 	regexp.MustCompile(parameterCDROM):                     "CDROM",
+	regexp.MustCompile(parameterCICustom):                  "CICustom",
+	regexp.MustCompile(parameterCIPassword):                "CIPassword",
+	regexp.MustCompile(parameterCIType):                    "CIType",
+	regexp.MustCompile(parameterCIUpgrade):                 "CIUpgrade",
+	regexp.MustCompile(parameterCIUser):                    "CIUser",
 	regexp.MustCompile(parameterCores):                     "Cores",
 	regexp.MustCompile(parameterCPU):                       "CPU",
 	regexp.MustCompile(parameterCPULimit):                  "CPULimit",
 	regexp.MustCompile(parameterCPUUnits):                  "CPUUnits",
 	regexp.MustCompile(parameterDescription):               "Description",
+	regexp.MustCompile(parameterDigest):                    "Digest",
+	regexp.MustCompile(parameterEFIDisk):                   "EFIDisk",
 	regexp.MustCompile(parameterForce):                     "Force",
 	regexp.MustCompile(parameterFreeze):                    "Freeze",
 	regexp.MustCompile(parameterHostPCI):                   "HostPCI",
 	regexp.MustCompile(parameterHotPlug):                   "HotPlug",
 	regexp.MustCompile(parameterHugePages):                 "HugePages",
 	regexp.MustCompile(`ide(\d+)`):                         "IDEDevices",
+	regexp.MustCompile(`ipconfig(\d+)`):                    "IPConfigs",
+	regexp.MustCompile(parameterIVShmem):                   "IVShmem",
 	regexp.MustCompile(parameterKeyboardLayout):            "KeyboardLayout",
 	regexp.MustCompile(parameterKVMHardwareVirtualization): "KVMHardwareVirtualization",
 	regexp.MustCompile(parameterLocalTime):                 "LocalTime",
@@ -103,6 +137,7 @@ var parameterMapping = map[*regexp.Regexp]string{
 	regexp.MustCompile(parameterMigrateDowntime):           "MigrateDowntime",
 	regexp.MustCompile(parameterMigrateSpeed):              "MigrateSpeed",
 	regexp.MustCompile(parameterName):                      "Name",
+	regexp.MustCompile(parameterNameserver):                "Nameserver",
 	regexp.MustCompile(`net(\d+)`):                         "NetworkDevices",
 	regexp.MustCompile(parameterNUMA):                      "NUMA",
 	regexp.MustCompile(`numa(\d+)`):                        "NUMATopologies",
@@ -112,92 +147,127 @@ var parameterMapping = map[*regexp.Regexp]string{
 	regexp.MustCompile(parameterPool):                      "Pool",
 	regexp.MustCompile(parameterProtection):                "Protection",
 	regexp.MustCompile(parameterReboot):                    "Reboot",
+	regexp.MustCompile(parameterRNG):                       "RNG",
 	regexp.MustCompile(`sata(\d+)`):                        "SATADevices",
 	regexp.MustCompile(`scsi(\d+)`):                        "SCSIDevices",
 	regexp.MustCompile(parameterSCSIControllerType):        "SCSIControllerType",
+	regexp.MustCompile(parameterSearchDomain):              "SearchDomain",
 	regexp.MustCompile(`serial(\d+)`):                      "SerialDevices",
 	regexp.MustCompile(parameterMemoryShares):              "MemoryShares",
 	regexp.MustCompile(parameterSMBIOS1):                   "SMBIOS1",
 	regexp.MustCompile(parameterSMP):                       "SMP",
 	regexp.MustCompile(parameterSockets):                   "Sockets",
+	regexp.MustCompile(parameterSSHKeys):                   "SSHKeys",
+	regexp.MustCompile(parameterSpiceEnhancements):         "SpiceEnhancements",
 	regexp.MustCompile(parameterStartDate):                 "StartDate",
 	regexp.MustCompile(parameterStartup):                   "Startup",
 	regexp.MustCompile(parameterStorage):                   "Storage",
 	regexp.MustCompile(parameterTablet):                    "Tablet",
 	regexp.MustCompile(parameterTDF):                       "TDF",
 	regexp.MustCompile(parameterTemplate):                  "Template",
+	regexp.MustCompile(parameterTPMState):                  "TPMState",
 	regexp.MustCompile(parameterUnique):                    "Unique",
 	regexp.MustCompile(`usb(\d+)`):                         "USBDevices",
 	regexp.MustCompile(parameterVCPUs):                     "VCPUs",
-	regexp.MustCompile(parameterVGA):                       "VGA",
+	regexp.MustCompile(parameterVGA):                       "VGAType",
 	regexp.MustCompile(`virtio(\d+)`):                      "VirtIODevices",
+	regexp.MustCompile(parameterVMGenID):                   "VMGenID",
 	regexp.MustCompile(parameterVMID):                      "VMID",
 	regexp.MustCompile(parameterWatchdog):                  "Watchdog",
 }
 
+// legacyBootOrderRegexp matches the old boot order syntax, e.g. "cdn".
+var legacyBootOrderRegexp = regexp.MustCompile(`^[acdn]{1,4}$`)
+
 type VMConfig struct {
 	// Enable/disable ACPI.
 	// default = 1
-	ACPI *bool
+	ACPI *bool `json:"acpi,omitempty"`
 
-	// Enable/disable Qemu GuestAgent.
-	// default = 0
-	QemuAgent *bool
+	// Enable/disable communication with the Qemu Guest Agent and its properties.
+	// [enabled=]<1|0> [,freeze-fs-on-backup=<1|0>] [,fstrim_cloned_disks=<1|0>] [,type=<virtio|isa>]
+	QemuAgent *QemuAgentOptions `json:"agent,omitempty"`
 
 	//
 	// The backup file.
-	Archive *string // TODO Create only
+	Archive *string `json:"archive,omitempty"` // TODO Create only
 
 	//
 	// Arbitrary arguments passed to kvm, for example:
 	// args: -no-reboot -no-hpet
-	Args *string
+	Args *string `json:"args,omitempty"`
+
+	//
+	// Configure a audio device, useful in combination with QXL/Spice.
+	Audio *AudioDevice `json:"audio0,omitempty"`
 
 	// Automatic restart after crash
 	// default = 0
-	AutoStart *bool
+	AutoStart *bool `json:"autostart,omitempty"`
 
 	// background_delay. Only post
 
 	//
 	// Amount of target RAM for the VM in MB. Using zero disables the balloon driver.
-	Balloon *int
+	Balloon *int `json:"balloon,omitempty"`
 
 	//
 	// Select BIOS implementation.
 	// default = seabios
-	Bios *Bios
+	Bios *Bios `json:"bios,omitempty"`
 
 	//
 	// Boot on floppy (a), hard disk (c), CD-ROM (d), or network (n).
 	// default = cdn
-	BootOrder []BootDevice
+	BootOrder []BootDevice `json:"boot,omitempty"`
 
 	//
 	// Enable booting from specified disk.
 	// (ide|sata|scsi|virtio)\d+
-	BootDisk *string
+	BootDisk *string `json:"bootdisk,omitempty"`
 
 	//
 	// <volume> This is an alias for option -ide2
-	CDROM *string
+	CDROM *string `json:"cdrom,omitempty"`
+
+	//
+	// cloud-init: Specify custom files to replace the automatically generated ones at start.
+	// [meta=<volume>] [,network=<volume>] [,user=<volume>] [,vendor=<volume>]
+	CICustom *string `json:"cicustom,omitempty"`
+
+	//
+	// cloud-init: Password to assign the user. Using this is generally not recommended. Use ssh keys instead.
+	CIPassword *string `json:"cipassword,omitempty"`
+
+	//
+	// Specifies the cloud-init configuration format. The default depends on the configured operating system type (ostype).
+	// We use the 'nocloud' format for Linux, and 'configdrive2' for windows.
+	CIType *CloudInitType `json:"citype,omitempty"`
+
+	//
+	// cloud-init: do an automatic package upgrade after the first boot.
+	// default = 1
+	CIUpgrade *bool `json:"ciupgrade,omitempty"`
+
+	//
+	// cloud-init: User name to change ssh keys and password for instead of the image's configured default user.
+	CIUser *string `json:"ciuser,omitempty"`
 
 	//
 	// The number of cores per socket.
 	// default = 1
-	Cores *int
+	Cores *int `json:"cores,omitempty"`
 
 	//
-	// [cputype=]<enum> [,hidden=<1|0>] Emulated CPU type.
-	// default = kvm64
-	// hidden=<boolean> Do not identify as a KVM virtual machine.
-	// default = 0
-	CPU *CPUType
+	// Emulated CPU type and CPU flags.
+	// [[cputype=]<string>] [,flags=<+FLAG[;-FLAG...]>] [,hidden=<1|0>] [,hv-vendor-id=<vendor-id>]
+	// [,phys-bits=<8-64|host>] [,reported-model=<enum>]
+	CPU *CPUOptions `json:"cpu,omitempty"`
 
 	//
 	// (0 - 128) Limit of CPU usage. NOTE: If the computer has 2 CPUs, it has total of '2' CPU time. Value '0' indicates no CPU limit.
 	// default = 0
-	CPULimit *int
+	CPULimit *int `json:"cpulimit,omitempty"`
 
 	//
 	// (0 - 500000) CPU weight for a VM. Argument is used in the kernel fair scheduler.
@@ -205,242 +275,302 @@ type VMConfig struct {
 	// Number is relative to weights of all the other running VMs.
 	// You can disable fair-scheduler configuration by setting this to 0.
 	// default = 1024
-	CPUUnits *int
-	//
+	CPUUnits *int `json:"cpuunits,omitempty"`
 
-	// TODO delete. Only post/put
+	//
+	// A list of settings you want to delete, e.g. "net1" or "description". Only used by UpdateVM.
+	Delete []string `json:"delete,omitempty"`
 
 	//
 	// Description for the VM. Only used on the configuration web interface.
 	// This is saved as comment inside the configuration file.
-	Description *string
+	Description *string `json:"description,omitempty"`
+
 	//
-	// TODO digest. Only post/put
+	// Configure a disk for storing EFI vars. Required when using the OVMF BIOS.
+	EFIDisk *EFIDisk `json:"efidisk0,omitempty"`
+
+	//
+	// SHA1 digest of the configuration as returned by GetVMConfig. When set, UpdateVM only succeeds
+	// if the configuration was not modified in the meantime, and fails with a VMConfigConflictError otherwise.
+	Digest *string `json:"digest,omitempty"`
+
 	//
 	// Allow to overwrite existing VM.
-	Force *bool
+	Force *bool `json:"force,omitempty"`
 	//
 
 	//
 	// Freeze CPU at startup (use 'c' monitor command to start execution).
-	Freeze *bool
+	Freeze *bool `json:"freeze,omitempty"`
 
 	//
 	// Map host PCI devices into guest.
 	// NOTE: This option allows direct access to host hardware.
 	// So it is no longer possible to migrate such machines - use with special care.
-	HostPCI *string
+	HostPCI *string `json:"hostpci,omitempty"`
 
 	//
 	// Selectively enable hotplug features.
 	// This is a comma separated list of hotplug features: 'network', 'disk', 'cpu', 'memory' and 'usb'.
 	// Use '0' to disable hotplug completely.
 	// Value '1' is an alias for the default 'network,disk,usb'.
-	HotPlug *string
+	// When unset, Proxmox uses the default. An empty set disables hotplug like '0'.
+	HotPlug *HotplugSet `json:"hotplug,omitempty"`
 
 	//
 	// Enable/disable hugepages memory.
-	HugePages *HugePages
+	HugePages *HugePages `json:"hugepages,omitempty"`
 
 	//
 	// Use volume as IDE hard disk or CD-ROM
-	IDEDevices map[int]*IDEDevice
+	IDEDevices map[int]*IDEDevice `json:"ide[n],omitempty"`
+
+	//
+	// cloud-init: Specify IP addresses and gateways for the corresponding interface (n is 0 to 31).
+	IPConfigs map[int]*IPConfig `json:"ipconfig[n],omitempty"`
+
+	//
+	// Inter-VM shared memory. Useful for direct communication between VMs, or to the host.
+	IVShmem *IVShmem `json:"ivshmem,omitempty"`
 
 	//
 	// Keyboard layout for vnc server. Default is read from the '/etc/pve/datacenter.conf' configuration file.
 	// default = en-us
-	KeyboardLayout *KeyboardLayout
+	KeyboardLayout *KeyboardLayout `json:"keyboard,omitempty"`
 
 	//
 	// Enable/disable KVM hardware virtualization.
 	// default = 1
-	KVMHardwareVirtualization *bool
+	KVMHardwareVirtualization *bool `json:"kvm,omitempty"`
 
 	//
 	// Set the real time clock to local time. This is enabled by default if ostype indicates a Microsoft OS.
-	LocalTime *bool
+	LocalTime *bool `json:"localtime,omitempty"`
 
 	//
 	// Lock/unlock the VM.
-	Lock *Lock
+	Lock *Lock `json:"lock,omitempty"`
 
 	//
-	// Specify the Qemu machine type.
-	// (pc|pc(-i440fx)?-\d+\.\d+(\.pxe)?|q35|pc-q35-\d+\.\d+(\.pxe)?)
-	MachineType *string
+	// Specify the Qemu machine type and the guest vIOMMU variant.
+	// [[type=]<type>] [,viommu=<intel|virtio>]
+	MachineType *Machine `json:"machine,omitempty"`
 
 	//
 	// Amount of RAM for the VM in MB. This is the maximum available memory when you use the balloon device.
 	// default = 512
-	Memory *int
+	Memory *int `json:"memory,omitempty"`
 
 	//
 	// (0 - N) Set maximum tolerated downtime (in seconds) for migrations.
 	// default = 0.1
-	MigrateDowntime *int
+	MigrateDowntime *int `json:"migrate_downtime,omitempty"`
 
 	//
 	// Set maximum speed (in MB/s) for migrations. Value 0 is no limit.
 	// default = 0
-	MigrateSpeed *int
+	MigrateSpeed *int `json:"migrate_speed,omitempty"`
 
 	//
 	// Set a name for the VM. Only used on the configuration web interface.
-	Name *string
+	Name *string `json:"name,omitempty"`
+
+	//
+	// cloud-init: Sets DNS server IP address for a container. Create will automatically use the setting from the host
+	// if neither searchdomain nor nameserver are set.
+	Nameserver *string `json:"nameserver,omitempty"`
 
 	//
 	// Specify network devices.
-	NetworkDevices map[int]*NetworkDevice
+	NetworkDevices map[int]*NetworkDevice `json:"net[n],omitempty"`
 
 	//
 	// Enable/disable NUMA.
 	// default = 0
-	NUMA *bool
+	NUMA *bool `json:"numa,omitempty"`
 
 	//
 	// NUMA topology.
-	NUMATopologies map[int]QMOption
+	NUMATopologies map[int]QMOption `json:"numa[n],omitempty"`
 
 	//
 	// Specifies whether a VM will be started during system bootup.
 	// default = 0
-	StartAtBoot *bool
+	StartAtBoot *bool `json:"onboot,omitempty"`
 
 	//
 	// Specify guest operating system. This is used to enable special optimization/features for specific operating systems.
-	OSType *OSType
+	OSType *OSType `json:"ostype,omitempty"`
 
 	//
 	// Map host parallel devices (n is 0 to 2).
 	// NOTE: This option allows direct access to host hardware.
 	// So it is no longer possible to migrate such machines - use with special care.
-	ParallelDevices map[int]QMOption
+	ParallelDevices map[int]QMOption `json:"parallel[n],omitempty"`
 
 	//
 	// Add theVM to the specified pool.
-	Pool *string // TODO Create only
+	Pool *string `json:"pool,omitempty"` // TODO Create only
 
 	//
 	// Sets the protection flag of the VM. This will disable the remove VM and remove disk operations.
-	Protection *bool
+	Protection *bool `json:"protection,omitempty"`
 
 	//
 	// Allow reboot. If set to '0' the VM exit on reboot.
-	Reboot *bool
+	Reboot *bool `json:"reboot,omitempty"`
 
 	//
-	// TODO revert. Only post/put
+	// Configure a VirtIO-based Random Number Generator.
+	RNG *RNGDevice `json:"rng0,omitempty"`
+
+	//
+	// Revert a pending change of the listed settings. Only used by UpdateVM.
+	Revert []string `json:"revert,omitempty"`
 
 	//
 	// Use volume as SATA hard disk or CD-ROM (n is 0 to 5).
-	SATADevices map[int]QMOption
+	SATADevices map[int]QMOption `json:"sata[n],omitempty"`
 
 	//
-	// Use volume as SCSI hard disk or CD-ROM (n is 0 to 13).
-	SCSIDevices map[int]QMOption
+	// Use volume as SCSI hard disk or CD-ROM (n is 0 to 30).
+	SCSIDevices map[int]QMOption `json:"scsi[n],omitempty"`
 
 	//
 	// SCSI controller model
 	// default = lsi
-	SCSIControllerType *SCSIControllerType
+	SCSIControllerType *SCSIControllerType `json:"scsihw,omitempty"`
+
+	//
+	// cloud-init: Sets DNS search domains for a container. Create will automatically use the setting from the host
+	// if neither searchdomain nor nameserver are set.
+	SearchDomain *string `json:"searchdomain,omitempty"`
 
 	//
 	// Create a serial device inside the VM (n is 0 to 3), and pass through a host serial device (i.e. /dev/ttyS0),
 	// or create a unix socket on the host side (use 'qm terminal' to open a terminal connection).
 	// NOTE: If you pass through a host serial device, it is no longer possible to migrate such machines - use with special care.
-	SerialDevices map[int]*SerialDevice
+	SerialDevices map[int]*SerialDevice `json:"serial[n],omitempty"`
 
 	//
 	// Amount of memory shares for auto-ballooning.
 	// The larger the number is, the more memory this VM gets.
 	// Number is relative to weights of all other running VMs. Using zero disables auto-ballooning
 	// default = 1000
-	MemoryShares *int
+	MemoryShares *int `json:"shares,omitempty"`
 
 	//
-	// TODO skiplock. Only post/put
+	// Ignore locks - only root is allowed to use this option. Only used by UpdateVM.
+	SkipLock *bool `json:"skiplock,omitempty"`
 
 	//
 	// Specify SMBIOS type 1 fields.
-	SMBIOS1 *string
+	SMBIOS1 *SMBIOS1 `json:"smbios1,omitempty"`
 
 	//
 	// The number of CPUs. Please use option -sockets instead.
 	// default = 1
-	SMP *int
+	SMP *int `json:"smp,omitempty"`
 
 	//
 	// The number of CPU sockets.
 	// default = 1
-	Sockets *int
+	Sockets *int `json:"sockets,omitempty"`
+
+	//
+	// cloud-init: Setup public SSH keys (one key per line, OpenSSH format).
+	SSHKeys *string `json:"sshkeys,omitempty"`
+
+	//
+	// Configure additional enhancements for SPICE.
+	SpiceEnhancements *SpiceEnhancements `json:"spice_enhancements,omitempty"`
 
 	//
 	// Set the initial date of the real time clock. Valid format for date are: 'now' or '2006-06-17T16:01:21' or'2006-06-17'.
 	// (now |YYYY-MM-DD | YYYY-MM-DDTHH:MM:SS)
 	// default = now
-	StartDate *string
+	StartDate *string `json:"startdate,omitempty"`
 
 	//
 	// Startup and shutdown behavior.
 	// Order is a non-negative number defining the general startup order. Shutdown is done with reverse ordering.
 	// Additionally you can set the 'up' or 'down' delay in seconds, which specifies a delay to wait before the next VM is started or stopped.
 	// [[order=]\d+] [,up=\d+] [,down=\d+]
-	Startup *string
+	Startup *StartupOrder `json:"startup,omitempty"`
 
 	//
 	// Default storage.
-	Storage *string // TODO Create only
+	Storage *string `json:"storage,omitempty"` // TODO Create only
 
 	//
 	// Enable/disable the USB tablet device. This device is usually needed to allow absolute mouse positioning with VNC.
 	// Else the mouse runs out of sync with normal VNC clients. If you're running lots of console-only guests on one host,
 	// you may consider disabling this to save some context switches. This is turned off by default if you use spice (-vga=qxl).
 	// default = 1
-	Tablet *bool
+	Tablet *bool `json:"tablet,omitempty"`
 
 	//
 	// Enable/disable time drift fix.
 	// default = 0
-	TDF *bool
+	TDF *bool `json:"tdf,omitempty"`
 
 	//
 	// Enable/disable Template.
 	// default = 0
-	Template *bool
+	Template *bool `json:"template,omitempty"`
+
+	//
+	// Configure a Disk for storing TPM state. The format is fixed to 'raw'.
+	TPMState *TPMState `json:"tpmstate0,omitempty"`
 
 	//
 	// Assign a unique random ethernet address.
-	Unique *bool // TODO Create only
+	Unique *bool `json:"unique,omitempty"` // TODO Create only
+
+	//
+	// Options this library does not model yet, keyed by their Proxmox name.
+	// They survive a round trip through the qemu-server .conf format but are not sent to the API.
+	Unknown map[string]string `json:"-"`
 
 	//
 	// Configure an USB device (n is 0 to 4).
-	USBDevices map[int]QMOption
+	USBDevices map[int]QMOption `json:"usb[n],omitempty"`
 
 	//
 	// Number of hotplugged vCPUs.
 	// default = 0
-	VCPUs *int
+	VCPUs *int `json:"vcpus,omitempty"`
 
 	//
 	// Select the VGA type. If you want to use high resolution modes (>= 1280x1024x16) then you should use the options std or vmware.
 	// Default is std for win8/win7/w2k8, and cirrus for other OS types. The qxl option enables the SPICE display sever.
 	// For win* OS you can select how many independent displays you want, Linux guests can add displays them self.
 	// You can also run without any graphic card, using a serial device as terminal.
-	VGAType *VGAType
+	VGAType *VGAType `json:"vga,omitempty"`
 
 	//
 	// Use volume as VirtIO hard disk (n is 0 to 15).
-	VirtIODevices map[int]*VirtIODevice
+	VirtIODevices map[int]*VirtIODevice `json:"virtio[n],omitempty"`
+
+	//
+	// The VM generation ID (vmgenid) device exposes a 128-bit integer value identifier to the guest OS.
+	// This allows to notify the guest operating system when the virtual machine is executed with a different
+	// configuration (e.g. snapshot execution or creation from a template).
+	// Use '1' to autogenerate on create or update, pass '0' to disable explicitly.
+	// (1 | 0 | [a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12})
+	// default = 1 (autogenerated)
+	VMGenID *string `json:"vmgenid,omitempty"`
 
 	//
 	// The (unique) ID of the VM.
-	VMID *int // TODO Create only
+	VMID *int `json:"vmid,omitempty"` // TODO Create only
 
 	//
 	// Create a virtual hardware watchdog device. Once enabled (by a guest action),
 	// the watchdog must be periodically polled by an agent inside the guest or else the watchdog will reset the guest
 	// (or execute the respective action specified)
-	Watchdog *string
+	Watchdog *string `json:"watchdog,omitempty"`
 }
 
 func NewVMConfigFromMap(data map[string]interface{}) *VMConfig {
@@ -448,113 +578,147 @@ func NewVMConfigFromMap(data map[string]interface{}) *VMConfig {
 	for k, v := range data {
 		fieldName, matchResults, err := findFieldName(k)
 		if err != nil {
+			config.addUnknown(k, interfaceToString(v))
 			continue
 		}
 		switch fieldName {
 		case "ACPI":
-			config.ACPI = Bool(intToBool(int(v.(float64))))
+			config.ACPI = Bool(intToBool(interfaceToInt(v)))
 		case "QemuAgent":
-			config.QemuAgent = Bool(intToBool(int(v.(float64))))
+			config.QemuAgent = NewQemuAgentOptionsFromString(interfaceToString(v))
+		case "Audio":
+			config.Audio = NewAudioDeviceFromString(interfaceToString(v))
 		case "AutoStart":
-			config.AutoStart = Bool(intToBool(int(v.(float64))))
+			config.AutoStart = Bool(intToBool(interfaceToInt(v)))
 		case "Bios":
-			v, err := BiosFromString(v.(string))
-			if err == nil {
-				config.Bios = &v
-			}
+			v, _ := BiosFromString(interfaceToString(v))
+			config.Bios = &v
 		case "BootOrder":
-			log.Printf("[DEBUG] Field %s is not supported yet", fieldName)
-		case "CPU":
-			v, err := CPUTypeFromString(v.(string))
-			if err == nil {
-				config.CPU = &v
+			value := interfaceToString(v)
+			if !legacyBootOrderRegexp.MatchString(value) {
+				// the "order=" syntax is not modelled yet, keep it verbatim
+				config.addUnknown(k, value)
+				continue
+			}
+			for _, r := range value {
+				device, _ := BootDeviceFromString(string(r))
+				config.BootOrder = append(config.BootOrder, device)
 			}
+		case "CIType":
+			v, _ := CloudInitTypeFromString(interfaceToString(v))
+			config.CIType = &v
+		case "CIUpgrade":
+			config.CIUpgrade = Bool(intToBool(interfaceToInt(v)))
+		case "CPU":
+			config.CPU = NewCPUOptionsFromString(interfaceToString(v))
+		case "EFIDisk":
+			config.EFIDisk = NewEFIDiskFromString(interfaceToString(v))
 		case "Force":
-			config.Force = Bool(intToBool(int(v.(float64))))
+			config.Force = Bool(intToBool(interfaceToInt(v)))
 		case "Freeze":
-			config.Freeze = Bool(intToBool(int(v.(float64))))
+			config.Freeze = Bool(intToBool(interfaceToInt(v)))
+		case "HotPlug":
+			hotplug := NewHotplugSetFromString(fmt.Sprintf("%v", v))
+			config.HotPlug = &hotplug
 		case "HugePages":
-			v, err := HugePagesFromString(v.(string))
-			if err == nil {
-				config.HugePages = &v
-			}
+			v, _ := HugePagesFromString(interfaceToString(v))
+			config.HugePages = &v
 		case "IDEDevices":
 			number, _ := strconv.Atoi(matchResults[1])
-			config.AddIDEDevice(number, NewIDEDeviceFromString(v.(string)))
+			config.AddIDEDevice(number, NewIDEDeviceFromString(interfaceToString(v)))
+		case "IPConfigs":
+			number, _ := strconv.Atoi(matchResults[1])
+			config.AddIPConfig(number, NewIPConfigFromString(interfaceToString(v)))
+		case "IVShmem":
+			config.IVShmem = NewIVShmemFromString(interfaceToString(v))
 		case "KeyboardLayout":
-			v, err := KeyboardLayoutFromString(v.(string))
-			if err == nil {
-				config.KeyboardLayout = &v
-			}
+			v, _ := KeyboardLayoutFromString(interfaceToString(v))
+			config.KeyboardLayout = &v
 		case "KVMHardwareVirtualization":
-			config.KVMHardwareVirtualization = Bool(intToBool(int(v.(float64))))
+			config.KVMHardwareVirtualization = Bool(intToBool(interfaceToInt(v)))
 		case "LocalTime":
-			config.LocalTime = Bool(intToBool(int(v.(float64))))
+			config.LocalTime = Bool(intToBool(interfaceToInt(v)))
 		case "Lock":
-			v, err := LockFromString(v.(string))
-			if err == nil {
-				config.Lock = &v
-			}
+			v, _ := LockFromString(interfaceToString(v))
+			config.Lock = &v
+		case "MachineType":
+			config.MachineType = NewMachineFromString(interfaceToString(v))
 		case "NetworkDevices":
 			number, _ := strconv.Atoi(matchResults[1])
-			config.AddNetworkDevice(number, NewNetworkDeviceFromString(v.(string)))
+			config.AddNetworkDevice(number, NewNetworkDeviceFromString(interfaceToString(v)))
 		case "NUMA":
-			config.NUMA = Bool(intToBool(int(v.(float64))))
+			config.NUMA = Bool(intToBool(interfaceToInt(v)))
 		case "NUMATopologies":
-			log.Printf("[DEBUG] Field %s is not supported yet", fieldName)
+			number, _ := strconv.Atoi(matchResults[1])
+			config.AddNUMATopology(number, NewRawQMOption(interfaceToString(v)))
 		case "StartAtBoot":
-			config.StartAtBoot = Bool(intToBool(int(v.(float64))))
+			config.StartAtBoot = Bool(intToBool(interfaceToInt(v)))
 		case "ParallelDevices":
-			log.Printf("[DEBUG] Field %s is not supported yet", fieldName)
+			number, _ := strconv.Atoi(matchResults[1])
+			config.AddParallelDevice(number, NewRawQMOption(interfaceToString(v)))
 		case "OSType":
-			v, err := OSTypeFromString(v.(string))
-			if err == nil {
-				config.OSType = &v
-			}
+			v, _ := OSTypeFromString(interfaceToString(v))
+			config.OSType = &v
 		case "Protection":
-			config.Protection = Bool(intToBool(int(v.(float64))))
+			config.Protection = Bool(intToBool(interfaceToInt(v)))
 		case "Reboot":
-			config.Reboot = Bool(intToBool(int(v.(float64))))
+			config.Reboot = Bool(intToBool(interfaceToInt(v)))
+		case "RNG":
+			config.RNG = NewRNGDeviceFromString(interfaceToString(v))
 		case "SATADevices":
-			log.Printf("[DEBUG] Field %s is not supported yet", fieldName)
+			number, _ := strconv.Atoi(matchResults[1])
+			config.AddSATADevice(number, NewRawQMOption(interfaceToString(v)))
 		case "SCSIDevices":
-			log.Printf("[DEBUG] Field %s is not supported yet", fieldName)
+			number, _ := strconv.Atoi(matchResults[1])
+			config.AddSCSIDevice(number, NewRawQMOption(interfaceToString(v)))
 		case "SCSIControllerType":
-			v, err := SCSIControllerTypeFromString(v.(string))
-			if err == nil {
-				config.SCSIControllerType = &v
-			}
+			v, _ := SCSIControllerTypeFromString(interfaceToString(v))
+			config.SCSIControllerType = &v
 		case "SerialDevices":
 			number, _ := strconv.Atoi(matchResults[1])
-			config.AddSerialDevice(number, NewSerialDeviceFromString(v.(string)))
+			config.AddSerialDevice(number, NewSerialDeviceFromString(interfaceToString(v)))
+		case "SSHKeys":
+			// the API and the .conf file hold the keys URL encoded
+			value := interfaceToString(v)
+			if keys, err := url.PathUnescape(value); err == nil {
+				value = keys
+			}
+			config.SSHKeys = String(value)
+		case "SMBIOS1":
+			config.SMBIOS1 = NewSMBIOS1FromString(interfaceToString(v))
+		case "SpiceEnhancements":
+			config.SpiceEnhancements = NewSpiceEnhancementsFromString(interfaceToString(v))
+		case "Startup":
+			config.Startup = NewStartupOrderFromString(fmt.Sprintf("%v", v))
 		case "Tablet":
-			config.Tablet = Bool(intToBool(int(v.(float64))))
+			config.Tablet = Bool(intToBool(interfaceToInt(v)))
 		case "TDF":
-			config.TDF = Bool(intToBool(int(v.(float64))))
+			config.TDF = Bool(intToBool(interfaceToInt(v)))
 		case "Template":
-			config.Template = Bool(intToBool(int(v.(float64))))
+			config.Template = Bool(intToBool(interfaceToInt(v)))
+		case "TPMState":
+			config.TPMState = NewTPMStateFromString(interfaceToString(v))
 		case "Unique":
-			config.Unique = Bool(intToBool(int(v.(float64))))
+			config.Unique = Bool(intToBool(interfaceToInt(v)))
 		case "USBDevices":
-			log.Printf("[DEBUG] Field %s is not supported yet", fieldName)
+			number, _ := strconv.Atoi(matchResults[1])
+			config.AddUSBDevice(number, NewRawQMOption(interfaceToString(v)))
 		case "VGAType":
-			v, err := VGATypeFromString(v.(string))
-			if err == nil {
-				config.VGAType = &v
-			}
+			v, _ := VGATypeFromString(interfaceToString(v))
+			config.VGAType = &v
 		case "VirtIODevices":
 			number, _ := strconv.Atoi(matchResults[1])
-			config.AddVirtIODevice(number, NewVirtIODeviceFromString(v.(string)))
+			config.AddVirtIODevice(number, NewVirtIODeviceFromString(interfaceToString(v)))
 		default:
 			s := reflect.Indirect(reflect.ValueOf(config))
 			field := s.FieldByName(fieldName)
 			log.Printf("[DEBUG] Field %s: %v\n", fieldName, v)
-			switch v.(type) {
-			case string:
-				val := v.(string)
+			switch field.Interface().(type) {
+			case *string:
+				val := interfaceToString(v)
 				field.Set(reflect.ValueOf(&val))
-			case float64:
-				val := int(v.(float64))
+			case *int:
+				val := interfaceToInt(v)
 				field.Set(reflect.ValueOf(&val))
 			default:
 				log.Printf("[DEBUG] Field %s: %v\n", fieldName, v)
@@ -568,14 +732,20 @@ func NewVMConfigFromMap(data map[string]interface{}) *VMConfig {
 func findFieldName(parameter string) (string, []string, error) {
 	for parameterRegexp, fieldName := range parameterMapping {
 		matchResults := parameterRegexp.FindStringSubmatch(parameter)
-		//matches := parameterRegexp.MatchString(parameter)
-		if len(matchResults) > 0 {
+		if len(matchResults) > 0 && matchResults[0] == parameter {
 			return fieldName, matchResults, nil
 		}
 	}
 	return "", nil, errors.New("Can't find fieldName for parameter " + parameter)
 }
 
+func (c *VMConfig) addUnknown(key, value string) {
+	if c.Unknown == nil {
+		c.Unknown = make(map[string]string)
+	}
+	c.Unknown[key] = value
+}
+
 func (c *VMConfig) AddIDEDevice(number int, value *IDEDevice) {
 	if c.IDEDevices == nil {
 		c.IDEDevices = make(map[int]*IDEDevice)
@@ -583,6 +753,13 @@ func (c *VMConfig) AddIDEDevice(number int, value *IDEDevice) {
 	c.IDEDevices[number] = value
 }
 
+func (c *VMConfig) AddIPConfig(number int, value *IPConfig) {
+	if c.IPConfigs == nil {
+		c.IPConfigs = make(map[int]*IPConfig)
+	}
+	c.IPConfigs[number] = value
+}
+
 func (c *VMConfig) AddNetworkDevice(number int, value *NetworkDevice) {
 	if c.NetworkDevices == nil {
 		c.NetworkDevices = make(map[int]*NetworkDevice)
@@ -646,7 +823,7 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		configMap[parameterACPI] = boolToString(BoolValue(c.ACPI))
 	}
 	if c.QemuAgent != nil {
-		configMap[parameterQemuAgent] = boolToString(BoolValue(c.QemuAgent))
+		configMap[parameterQemuAgent] = c.QemuAgent.GetQMOptionValue()
 	}
 	if c.Archive != nil {
 		configMap[parameterArchive] = StringValue(c.Archive)
@@ -654,6 +831,9 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.Args != nil {
 		configMap[parameterArgs] = StringValue(c.Args)
 	}
+	if c.Audio != nil {
+		configMap[parameterAudio] = c.Audio.GetQMOptionValue()
+	}
 	if c.AutoStart != nil {
 		configMap[parameterAutoStart] = boolToString(BoolValue(c.AutoStart))
 	}
@@ -683,6 +863,21 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.CDROM != nil {
 		configMap[parameterCDROM] = StringValue(c.CDROM)
 	}
+	if c.CICustom != nil {
+		configMap[parameterCICustom] = StringValue(c.CICustom)
+	}
+	if c.CIPassword != nil {
+		configMap[parameterCIPassword] = StringValue(c.CIPassword)
+	}
+	if c.CIType != nil {
+		configMap[parameterCIType] = c.CIType.String()
+	}
+	if c.CIUpgrade != nil {
+		configMap[parameterCIUpgrade] = boolToString(BoolValue(c.CIUpgrade))
+	}
+	if c.CIUser != nil {
+		configMap[parameterCIUser] = StringValue(c.CIUser)
+	}
 	if c.Cores != nil {
 		value := IntValue(c.Cores)
 		if value < 1 {
@@ -691,7 +886,7 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		configMap[parameterCores] = strconv.Itoa(value)
 	}
 	if c.CPU != nil {
-		configMap[parameterCPU] = c.CPU.String()
+		configMap[parameterCPU] = c.CPU.GetQMOptionValue()
 	}
 	if c.CPULimit != nil {
 		value := IntValue(c.CPULimit)
@@ -707,9 +902,18 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		}
 		configMap[parameterCPUUnits] = strconv.Itoa(value)
 	}
+	if c.Delete != nil {
+		configMap[parameterDelete] = strings.Join(c.Delete, ",")
+	}
 	if c.Description != nil {
 		configMap[parameterDescription] = StringValue(c.Description)
 	}
+	if c.EFIDisk != nil {
+		configMap[parameterEFIDisk] = c.EFIDisk.GetQMOptionValue()
+	}
+	if c.Digest != nil {
+		configMap[parameterDigest] = StringValue(c.Digest)
+	}
 	if c.Force != nil {
 		configMap[parameterForce] = boolToString(BoolValue(c.Force))
 	}
@@ -720,7 +924,7 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		configMap[parameterHostPCI] = StringValue(c.HostPCI)
 	}
 	if c.HotPlug != nil {
-		configMap[parameterHotPlug] = StringValue(c.HotPlug)
+		configMap[parameterHotPlug] = c.HotPlug.GetQMOptionValue()
 	}
 	if c.HugePages != nil {
 		configMap[parameterHugePages] = c.HugePages.String()
@@ -738,6 +942,22 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 			}
 		}
 	}
+	if c.IPConfigs != nil {
+		for number, v := range c.IPConfigs {
+			if number < 0 || number > 31 {
+				return nil, NewArgError(fmt.Sprintf("%s[n]", parameterIPConfigs), "it must be 0 to 31")
+			} else {
+				key := fmt.Sprintf("%s%d", parameterIPConfigs, number)
+				configMap[key] = v.GetQMOptionValue()
+			}
+		}
+	}
+	if c.IVShmem != nil {
+		if IntValue(c.IVShmem.Size) < 1 {
+			return nil, NewArgError(parameterIVShmem, "size must be >= 1")
+		}
+		configMap[parameterIVShmem] = c.IVShmem.GetQMOptionValue()
+	}
 	if c.KeyboardLayout != nil {
 		configMap[parameterKeyboardLayout] = c.KeyboardLayout.String()
 	}
@@ -751,7 +971,10 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		configMap[parameterLock] = c.Lock.String()
 	}
 	if c.MachineType != nil {
-		configMap[parameterMachineType] = StringValue(c.MachineType)
+		if c.MachineType.VIOMMU != nil && *c.MachineType.VIOMMU == VIOMMU_Intel && !c.MachineType.IsQ35() {
+			return nil, NewArgError(parameterMachineType, "the intel vIOMMU requires a q35 machine type")
+		}
+		configMap[parameterMachineType] = c.MachineType.GetQMOptionValue()
 	}
 	if c.Memory != nil {
 		value := IntValue(c.Memory)
@@ -777,10 +1000,13 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.Name != nil {
 		configMap[parameterName] = StringValue(c.Name)
 	}
+	if c.Nameserver != nil {
+		configMap[parameterNameserver] = StringValue(c.Nameserver)
+	}
 	if c.NetworkDevices != nil {
 		for number, v := range c.NetworkDevices {
-			if number < 0 {
-				return nil, NewArgError(fmt.Sprintf("%s[n]", parameterNetworkDevices), "it must be > 0")
+			if number < 0 || number > 31 {
+				return nil, NewArgError(fmt.Sprintf("%s[n]", parameterNetworkDevices), "it must be 0 to 31")
 			} else {
 				key := fmt.Sprintf("%s%d", parameterNetworkDevices, number)
 				configMap[key] = v.GetQMOptionValue()
@@ -828,6 +1054,12 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.Reboot != nil {
 		configMap[parameterReboot] = boolToString(BoolValue(c.Reboot))
 	}
+	if c.Revert != nil {
+		configMap[parameterRevert] = strings.Join(c.Revert, ",")
+	}
+	if c.RNG != nil {
+		configMap[parameterRNG] = c.RNG.GetQMOptionValue()
+	}
 	if c.SATADevices != nil {
 		if len(c.SATADevices) > 6 {
 			return nil, NewArgError(fmt.Sprintf("%s[n]", parameterSATADevices), "there are too many SATA devices specified. Max. 6")
@@ -842,12 +1074,12 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		}
 	}
 	if c.SCSIDevices != nil {
-		if len(c.SCSIDevices) > 14 {
-			return nil, NewArgError(fmt.Sprintf("%s[n]", parameterSCSIDevices), "there are too many SCSI devices specified. Max. 14")
+		if len(c.SCSIDevices) > 31 {
+			return nil, NewArgError(fmt.Sprintf("%s[n]", parameterSCSIDevices), "there are too many SCSI devices specified. Max. 31")
 		}
 		for number, v := range c.SCSIDevices {
-			if number < 0 || number > 13 {
-				return nil, NewArgError(fmt.Sprintf("%s[n]", parameterSCSIDevices), "it must be 0 to 13")
+			if number < 0 || number > 30 {
+				return nil, NewArgError(fmt.Sprintf("%s[n]", parameterSCSIDevices), "it must be 0 to 30")
 			} else {
 				key := fmt.Sprintf("%s%d", parameterSCSIDevices, number)
 				configMap[key] = v.GetQMOptionValue()
@@ -857,6 +1089,9 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.SCSIControllerType != nil {
 		configMap[parameterSCSIControllerType] = c.SCSIControllerType.String()
 	}
+	if c.SearchDomain != nil {
+		configMap[parameterSearchDomain] = StringValue(c.SearchDomain)
+	}
 	if c.SerialDevices != nil {
 		if len(c.SerialDevices) > 4 {
 			return nil, NewArgError(fmt.Sprintf("%s[n]", parameterSerialDevices), "there are too many serial devices specified. Max. 4")
@@ -877,8 +1112,11 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		}
 		configMap[parameterMemoryShares] = strconv.Itoa(value)
 	}
+	if c.SkipLock != nil {
+		configMap[parameterSkipLock] = boolToString(BoolValue(c.SkipLock))
+	}
 	if c.SMBIOS1 != nil {
-		configMap[parameterSMBIOS1] = StringValue(c.SMBIOS1)
+		configMap[parameterSMBIOS1] = c.SMBIOS1.GetQMOptionValue()
 	}
 	if c.SMP != nil {
 		value := IntValue(c.SMP)
@@ -894,11 +1132,17 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 		}
 		configMap[parameterSockets] = strconv.Itoa(value)
 	}
+	if c.SSHKeys != nil {
+		configMap[parameterSSHKeys] = url.PathEscape(StringValue(c.SSHKeys))
+	}
+	if c.SpiceEnhancements != nil {
+		configMap[parameterSpiceEnhancements] = c.SpiceEnhancements.GetQMOptionValue()
+	}
 	if c.StartDate != nil {
 		configMap[parameterStartDate] = StringValue(c.StartDate)
 	}
 	if c.Startup != nil {
-		configMap[parameterStartup] = StringValue(c.Startup)
+		configMap[parameterStartup] = c.Startup.GetQMOptionValue()
 	}
 	if c.Storage != nil {
 		configMap[parameterStorage] = StringValue(c.Storage)
@@ -912,6 +1156,9 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.Template != nil {
 		configMap[parameterTemplate] = boolToString(BoolValue(c.Template))
 	}
+	if c.TPMState != nil {
+		configMap[parameterTPMState] = c.TPMState.GetQMOptionValue()
+	}
 	if c.Unique != nil {
 		configMap[parameterUnique] = boolToString(BoolValue(c.Unique))
 	}
@@ -947,6 +1194,9 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 			}
 		}
 	}
+	if c.VMGenID != nil {
+		configMap[parameterVMGenID] = StringValue(c.VMGenID)
+	}
 	if c.VMID != nil {
 		value := IntValue(c.VMID)
 		if value < 100 {
@@ -957,6 +1207,11 @@ func (c *VMConfig) GetOptionsMap() (map[string]string, error) {
 	if c.Watchdog != nil {
 		configMap[parameterWatchdog] = StringValue(c.Watchdog)
 	}
+	for _, key := range c.Delete {
+		if _, ok := configMap[key]; ok {
+			return nil, NewArgError(parameterDelete, fmt.Sprintf("%s can't be set and deleted at the same time", key))
+		}
+	}
 
 	return configMap, nil
 }
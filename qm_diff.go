@@ -0,0 +1,353 @@
+package goproxmox
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type VMConfigChangeType string
+
+const (
+	VMConfigChangeAdded   VMConfigChangeType = "added"
+	VMConfigChangeChanged VMConfigChangeType = "changed"
+	VMConfigChangeRemoved VMConfigChangeType = "removed"
+)
+
+type VMDiskOperation string
+
+const (
+	VMDiskOperationResize VMDiskOperation = "resize"
+	VMDiskOperationMove   VMDiskOperation = "move"
+)
+
+// VMConfigChange is a single difference between a current and a desired VM config.
+type VMConfigChange struct {
+	Key  string
+	Type VMConfigChangeType
+
+	// Old and New hold the option values. Old is empty for added keys, New for removed keys.
+	Old string
+	New string
+
+	// The change only takes effect on a running VM after a reboot.
+	RequiresReboot bool
+
+	// The change is hotplugged into a running VM.
+	Hotplug bool
+
+	// DiskOperation is set if the change is applied with a disk operation instead of a config update.
+	// New holds the target size for a resize and the target storage for a move.
+	DiskOperation VMDiskOperation
+}
+
+// VMConfigDiff is the set of changes required to turn a current VM config into a desired one.
+type VMConfigDiff struct {
+	// Digest of the current config. ApplyVMConfigDiff sends it to detect concurrent modifications.
+	Digest string
+
+	Changes []VMConfigChange
+}
+
+// VMConfigDiffApplyConfig holds the optional parameters of ApplyVMConfigDiff.
+type VMConfigDiffApplyConfig struct {
+	// Delete the source volumes of moved disks. By default they are kept as unusedN, so that applying a diff
+	// of a stale desired config doesn't destroy data
+	DeleteMovedDisks bool
+}
+
+var (
+	diskParameterRegexp = regexp.MustCompile(`^(ide|sata|scsi|virtio)\d+$|^efidisk0$|^tpmstate0$`)
+	hotplugDiskRegexp   = regexp.MustCompile(`^(sata|scsi|virtio)\d+$`)
+	ideDiskRegexp       = regexp.MustCompile(`^ide\d+$`)
+	networkRegexp       = regexp.MustCompile(`^net\d+$`)
+	usbRegexp           = regexp.MustCompile(`^usb\d+$`)
+	diskSizeRegexp      = regexp.MustCompile(`^(\d+(?:\.\d+)?)([KMGT]?)$`)
+	// storage:size syntax used to allocate a new volume, size in GiB
+	diskAllocationRegexp = regexp.MustCompile(`^([^:]+):(\d+(?:\.\d+)?)$`)
+)
+
+// Keys that are ignored when diffing, either because they are request-only or managed by Proxmox.
+var diffIgnoredParameters = map[string]bool{
+	parameterArchive:  true,
+	parameterDelete:   true,
+	parameterDigest:   true,
+	parameterForce:    true,
+	parameterLock:     true,
+	parameterParent:   true,
+	parameterPool:     true,
+	parameterRevert:   true,
+	parameterSkipLock: true,
+	parameterStorage:  true,
+	parameterUnique:   true,
+	parameterVMID:     true,
+}
+
+// Keys that Proxmox generates when they are not specified. They are only diffed if the desired config sets them.
+var diffGeneratedParameters = map[string]bool{
+	parameterMeta:    true,
+	parameterSMBIOS1: true,
+	parameterVMGenID: true,
+}
+
+// Keys that are applied immediately, even to a running VM.
+var fastPlugParameters = map[string]bool{
+	parameterDescription:  true,
+	parameterHotPlug:      true,
+	parameterName:         true,
+	parameterProtection:   true,
+	parameterStartAtBoot:  true,
+	parameterStartup:      true,
+	parameterMemoryShares: true,
+}
+
+// Keys that Proxmox always hotplugs into a running VM.
+var alwaysHotplugParameters = map[string]bool{
+	parameterBalloon:  true,
+	parameterCPULimit: true,
+	parameterCPUUnits: true,
+}
+
+// DiffVMConfig compares the current config of a VM with the desired one. Keys that are set in current
+// but not in desired are removed, except for values Proxmox generates itself such as vmgenid or smbios1,
+// and unusedN volumes, whose removal would destroy them. Options in Unknown are compared as well.
+// A desired disk size below the current one fails with an ArgError, as Proxmox can't shrink disks.
+func DiffVMConfig(current, desired *VMConfig) (*VMConfigDiff, error) {
+	currentOptions, err := current.GetOptionsMap()
+	if err != nil {
+		return nil, err
+	}
+	desiredOptions, err := desired.GetOptionsMap()
+	if err != nil {
+		return nil, err
+	}
+	addUnknownOptions(currentOptions, current)
+	addUnknownOptions(desiredOptions, desired)
+	for key := range diffIgnoredParameters {
+		delete(currentOptions, key)
+		delete(desiredOptions, key)
+	}
+	for key := range diffGeneratedParameters {
+		if _, ok := desiredOptions[key]; !ok {
+			delete(currentOptions, key)
+		}
+	}
+
+	hotplug := HotplugSet{HotplugDefaults}
+	if current.HotPlug != nil {
+		hotplug = *current.HotPlug
+	}
+
+	diff := &VMConfigDiff{Digest: StringValue(current.Digest)}
+	for _, key := range sortedKeys(desiredOptions) {
+		newValue := desiredOptions[key]
+		oldValue, ok := currentOptions[key]
+		if !ok {
+			diff.Changes = append(diff.Changes, newVMConfigChange(key, VMConfigChangeAdded, "", newValue, hotplug))
+			continue
+		}
+		if diskParameterRegexp.MatchString(key) {
+			changes, err := diffDisk(key, oldValue, newValue, hotplug)
+			if err != nil {
+				return nil, err
+			}
+			diff.Changes = append(diff.Changes, changes...)
+			continue
+		}
+		if networkRegexp.MatchString(key) {
+			newValue = inheritNetworkMacAddr(oldValue, newValue)
+		}
+		if oldValue != newValue {
+			diff.Changes = append(diff.Changes, newVMConfigChange(key, VMConfigChangeChanged, oldValue, newValue, hotplug))
+		}
+	}
+	for _, key := range sortedKeys(currentOptions) {
+		if _, ok := desiredOptions[key]; !ok && !unusedRegexp.MatchString(key) {
+			diff.Changes = append(diff.Changes, newVMConfigChange(key, VMConfigChangeRemoved, currentOptions[key], "", hotplug))
+		}
+	}
+
+	return diff, nil
+}
+
+// addUnknownOptions adds the options in config.Unknown to options, e.g. hostpciN or a boot order that
+// VMConfig doesn't model.
+func addUnknownOptions(options map[string]string, config *VMConfig) {
+	for key, value := range config.Unknown {
+		if _, ok := options[key]; !ok {
+			options[key] = value
+		}
+	}
+}
+
+func newVMConfigChange(key string, changeType VMConfigChangeType, oldValue, newValue string, hotplug HotplugSet) VMConfigChange {
+	change := VMConfigChange{Key: key, Type: changeType, Old: oldValue, New: newValue}
+	switch {
+	case fastPlugParameters[key]:
+	case alwaysHotplugParameters[key]:
+		change.Hotplug = true
+	default:
+		feature := hotplugFeatureForParameter(key, oldValue+","+newValue)
+		change.Hotplug = feature != "" && hotplug.Has(feature)
+		change.RequiresReboot = !change.Hotplug
+	}
+	return change
+}
+
+func hotplugFeatureForParameter(key, value string) HotplugFeature {
+	switch {
+	case key == parameterMemory:
+		return HotplugMemory
+	case key == parameterVCPUs:
+		return HotplugCPU
+	case networkRegexp.MatchString(key):
+		return HotplugNetwork
+	case usbRegexp.MatchString(key):
+		return HotplugUSB
+	case hotplugDiskRegexp.MatchString(key):
+		return HotplugDisk
+	case ideDiskRegexp.MatchString(key) && strings.Contains(value, "media=cdrom"):
+		// only the medium of an IDE CD-ROM drive can be changed while running
+		return HotplugDisk
+	}
+	return ""
+}
+
+// diffDisk splits a disk change into resize and move operations and a config update for the remaining options.
+// The desired file may use the storage:size allocation syntax.
+func diffDisk(key, oldValue, newValue string, hotplug HotplugSet) ([]VMConfigChange, error) {
+	oldOptions := parseQMOptionValue(oldValue, "file")
+	newOptions := parseQMOptionValue(newValue, "file")
+	changes := make([]VMConfigChange, 0)
+	if oldValue == newValue {
+		return changes, nil
+	}
+
+	oldStorage := volumeStorage(oldOptions["file"])
+	newStorage := volumeStorage(newOptions["file"])
+	newSize := newOptions["size"]
+	if matchResults := diskAllocationRegexp.FindStringSubmatch(newOptions["file"]); len(matchResults) > 2 {
+		newStorage = matchResults[1]
+		newSize = matchResults[2] + "G"
+	}
+	isCDROM := oldOptions["media"] == "cdrom" || newOptions["media"] == "cdrom"
+
+	if !isCDROM && newStorage != "" && oldStorage != "" && newStorage != oldStorage {
+		changes = append(changes, VMConfigChange{Key: key, Type: VMConfigChangeChanged, Old: oldStorage, New: newStorage, DiskOperation: VMDiskOperationMove})
+	}
+	if !isCDROM && newSize != "" {
+		switch oldSize := oldOptions["size"]; {
+		case parseDiskSize(newSize) > parseDiskSize(oldSize):
+			changes = append(changes, VMConfigChange{Key: key, Type: VMConfigChangeChanged, Old: oldSize, New: newSize, DiskOperation: VMDiskOperationResize})
+		case parseDiskSize(newSize) < parseDiskSize(oldSize):
+			return nil, NewArgError(key+".size", fmt.Sprintf("the disk can't be shrunk from %s to %s", oldSize, newSize))
+		}
+	}
+
+	if isCDROM {
+		// a different medium is a plain config change
+		return append(changes, newVMConfigChange(key, VMConfigChangeChanged, oldValue, newValue, hotplug)), nil
+	}
+
+	// keep the existing volume and apply the remaining options
+	mergedOptions := make(map[string]string)
+	for k, v := range newOptions {
+		mergedOptions[k] = v
+	}
+	mergedOptions["file"] = oldOptions["file"]
+	if size, ok := oldOptions["size"]; ok {
+		mergedOptions["size"] = size
+	} else {
+		delete(mergedOptions, "size")
+	}
+	mergedValue := formatQMOptionValue(mergedOptions, "file")
+	if mergedValue != formatQMOptionValue(oldOptions, "file") {
+		changes = append(changes, newVMConfigChange(key, VMConfigChangeChanged, oldValue, mergedValue, hotplug))
+	}
+	return changes, nil
+}
+
+// inheritNetworkMacAddr copies the MAC address of the current network device if the desired one doesn't set it,
+// so an autogenerated address is not reported as a change.
+func inheritNetworkMacAddr(oldValue, newValue string) string {
+	oldDevice := NewNetworkDeviceFromString(oldValue)
+	newDevice := NewNetworkDeviceFromString(newValue)
+	if newDevice.MacAddr != nil || oldDevice.MacAddr == nil {
+		return newValue
+	}
+	newDevice.MacAddr = oldDevice.MacAddr
+	if oldDevice.GetQMOptionValue() == newDevice.GetQMOptionValue() {
+		return oldValue
+	}
+	return newDevice.GetQMOptionValue()
+}
+
+// volumeStorage returns the storage ID of a volume like "local-lvm:vm-100-disk-0".
+func volumeStorage(volume string) string {
+	if i := strings.Index(volume, ":"); i > 0 {
+		return volume[:i]
+	}
+	return ""
+}
+
+// parseDiskSize converts a disk size like "32G" into bytes. Sizes without unit are bytes.
+func parseDiskSize(size string) int64 {
+	matchResults := diskSizeRegexp.FindStringSubmatch(size)
+	if len(matchResults) < 3 {
+		return 0
+	}
+	value, _ := strconv.ParseFloat(matchResults[1], 64)
+	unit := map[string]float64{"": 1, "K": 1 << 10, "M": 1 << 20, "G": 1 << 30, "T": 1 << 40}[matchResults[2]]
+	return int64(value * unit)
+}
+
+// IsEmpty reports whether there is nothing to change.
+func (d *VMConfigDiff) IsEmpty() bool {
+	return len(d.Changes) == 0
+}
+
+// RequiresReboot reports whether any of the changes only takes effect after a reboot.
+func (d *VMConfigDiff) RequiresReboot() bool {
+	for _, change := range d.Changes {
+		if change.RequiresReboot {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the diff as a human-readable plan.
+func (d *VMConfigDiff) String() string {
+	var buf bytes.Buffer
+	var added, changed, removed, diskOperations int
+	for _, change := range d.Changes {
+		var line string
+		switch {
+		case change.DiskOperation == VMDiskOperationResize:
+			diskOperations++
+			line = fmt.Sprintf("~ %s: resize %s => %s", change.Key, change.Old, change.New)
+		case change.DiskOperation == VMDiskOperationMove:
+			diskOperations++
+			line = fmt.Sprintf("~ %s: move from storage %s to %s", change.Key, change.Old, change.New)
+		case change.Type == VMConfigChangeAdded:
+			added++
+			line = fmt.Sprintf("+ %s: %s", change.Key, change.New)
+		case change.Type == VMConfigChangeChanged:
+			changed++
+			line = fmt.Sprintf("~ %s: %s => %s", change.Key, change.Old, change.New)
+		case change.Type == VMConfigChangeRemoved:
+			removed++
+			line = fmt.Sprintf("- %s", change.Key)
+		}
+		if change.RequiresReboot {
+			line += " (reboot required)"
+		} else if change.Hotplug {
+			line += " (hotplug)"
+		}
+		buf.WriteString(line + "\n")
+	}
+	fmt.Fprintf(&buf, "Plan: %d to add, %d to change, %d to remove, %d disk operations.\n", added, changed, removed, diskOperations)
+	return buf.String()
+}
@@ -0,0 +1,158 @@
+package goproxmox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDiskSize(t *testing.T) {
+	tests := []struct {
+		size  string
+		bytes int64
+	}{
+		{size: "512", bytes: 512},
+		{size: "64K", bytes: 64 << 10},
+		{size: "100M", bytes: 100 << 20},
+		{size: "32G", bytes: 32 << 30},
+		{size: "1.5G", bytes: 3 << 29},
+		{size: "2T", bytes: 2 << 40},
+		{size: "", bytes: 0},
+		{size: "10X", bytes: 0},
+	}
+
+	for _, test := range tests {
+		if bytes := parseDiskSize(test.size); bytes != test.bytes {
+			t.Errorf("%q: %d bytes, want %d", test.size, bytes, test.bytes)
+		}
+	}
+}
+
+func TestDiffDisk(t *testing.T) {
+	const disk = "local-lvm:vm-100-disk-0,size=32G"
+	tests := []struct {
+		key      string
+		old, new string
+		changes  []VMConfigChange
+		err      bool
+	}{
+		{key: "scsi0", old: disk, new: disk, changes: []VMConfigChange{}},
+		{key: "scsi0", old: disk, new: "local-lvm:vm-100-disk-0,size=40G", changes: []VMConfigChange{
+			{Key: "scsi0", Type: VMConfigChangeChanged, Old: "32G", New: "40G", DiskOperation: VMDiskOperationResize},
+		}},
+		{key: "scsi0", old: disk, new: "ceph:vm-100-disk-0,size=32G", changes: []VMConfigChange{
+			{Key: "scsi0", Type: VMConfigChangeChanged, Old: "local-lvm", New: "ceph", DiskOperation: VMDiskOperationMove},
+		}},
+		{key: "scsi0", old: disk, new: "ceph:40", changes: []VMConfigChange{
+			{Key: "scsi0", Type: VMConfigChangeChanged, Old: "local-lvm", New: "ceph", DiskOperation: VMDiskOperationMove},
+			{Key: "scsi0", Type: VMConfigChangeChanged, Old: "32G", New: "40G", DiskOperation: VMDiskOperationResize},
+		}},
+		{key: "scsi0", old: disk, new: "local-lvm:vm-100-disk-0,discard=on,size=32G", changes: []VMConfigChange{
+			{Key: "scsi0", Type: VMConfigChangeChanged, Old: disk, New: "local-lvm:vm-100-disk-0,discard=on,size=32G", Hotplug: true},
+		}},
+		{key: "ide0", old: "local-lvm:vm-100-disk-0,size=32G", new: "local-lvm:vm-100-disk-0,cache=writeback,size=32G", changes: []VMConfigChange{
+			{Key: "ide0", Type: VMConfigChangeChanged, Old: disk, New: "local-lvm:vm-100-disk-0,cache=writeback,size=32G", RequiresReboot: true},
+		}},
+		{key: "ide2", old: "local:iso/a.iso,media=cdrom", new: "local:iso/b.iso,media=cdrom", changes: []VMConfigChange{
+			{Key: "ide2", Type: VMConfigChangeChanged, Old: "local:iso/a.iso,media=cdrom", New: "local:iso/b.iso,media=cdrom", Hotplug: true},
+		}},
+		{key: "scsi0", old: disk, new: "local-lvm:vm-100-disk-0,size=16G", err: true},
+	}
+
+	for _, test := range tests {
+		changes, err := diffDisk(test.key, test.old, test.new, HotplugSet{HotplugDefaults})
+		if (err != nil) != test.err {
+			t.Errorf("%s: %q => %q: err = %v, want error %v", test.key, test.old, test.new, err, test.err)
+			continue
+		}
+		if !test.err && !reflect.DeepEqual(changes, test.changes) {
+			t.Errorf("%s: %q => %q:\ngot  %+v\nwant %+v", test.key, test.old, test.new, changes, test.changes)
+		}
+	}
+}
+
+func TestDiffVMConfig(t *testing.T) {
+	current := &VMConfig{
+		Name:        String("web"),
+		Memory:      Int(2048),
+		Cores:       Int(2),
+		Balloon:     Int(1024),
+		Description: String("old"),
+		SMBIOS1:     NewSMBIOS1FromString("uuid=7b5d3a2e-8c4f-4e3b-9f6a-1d2c3b4a5f6e"),
+		StartAtBoot: Bool(true),
+		Digest:      String("abc"),
+		Unknown: map[string]string{
+			"boot":    "order=scsi0;net0",
+			"meta":    "creation-qemu=8.1.5,ctime=1700000000",
+			"parent":  "before-upgrade",
+			"unused0": "local-lvm:vm-100-disk-1",
+		},
+	}
+	current.AddNetworkDevice(0, NewNetworkDeviceFromString("virtio=AA:BB:CC:DD:EE:FF,bridge=vmbr0"))
+	net := func(value string) string { return NewNetworkDeviceFromString(value).GetQMOptionValue() }
+
+	tests := []struct {
+		name    string
+		desired *VMConfig
+		changes []VMConfigChange
+	}{
+		{name: "equal", desired: &VMConfig{
+			Name: String("web"), Memory: Int(2048), Cores: Int(2), Balloon: Int(1024), Description: String("old"), StartAtBoot: Bool(true),
+			Unknown: map[string]string{"boot": "order=scsi0;net0"},
+			// the MAC address of the current device is kept
+			NetworkDevices: map[int]*NetworkDevice{0: NewNetworkDeviceFromString("virtio,bridge=vmbr0")},
+		}},
+		{name: "changes", desired: &VMConfig{
+			Name: String("web"), Memory: Int(4096), Cores: Int(4), Balloon: Int(2048), Description: String("new"), Sockets: Int(1),
+			NetworkDevices: map[int]*NetworkDevice{0: NewNetworkDeviceFromString("virtio,bridge=vmbr1")},
+			Unknown:        map[string]string{"boot": "order=net0;scsi0", "hostpci0": "0000:01:00.0"},
+		}, changes: []VMConfigChange{
+			{Key: "balloon", Type: VMConfigChangeChanged, Old: "1024", New: "2048", Hotplug: true},
+			{Key: "boot", Type: VMConfigChangeChanged, Old: "order=scsi0;net0", New: "order=net0;scsi0", RequiresReboot: true},
+			{Key: "cores", Type: VMConfigChangeChanged, Old: "2", New: "4", RequiresReboot: true},
+			{Key: "description", Type: VMConfigChangeChanged, Old: "old", New: "new"},
+			{Key: "hostpci0", Type: VMConfigChangeAdded, New: "0000:01:00.0", RequiresReboot: true},
+			{Key: "memory", Type: VMConfigChangeChanged, Old: "2048", New: "4096", RequiresReboot: true},
+			{Key: "net0", Type: VMConfigChangeChanged, Old: net("virtio=AA:BB:CC:DD:EE:FF,bridge=vmbr0"), New: net("virtio=AA:BB:CC:DD:EE:FF,bridge=vmbr1"), Hotplug: true},
+			{Key: "sockets", Type: VMConfigChangeAdded, New: "1", RequiresReboot: true},
+			{Key: "onboot", Type: VMConfigChangeRemoved, Old: "1"},
+		}},
+	}
+
+	for _, test := range tests {
+		diff, err := DiffVMConfig(current, test.desired)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if diff.Digest != "abc" {
+			t.Errorf("%s: digest = %q, want the digest of the current config", test.name, diff.Digest)
+		}
+		if !reflect.DeepEqual(diff.Changes, test.changes) {
+			t.Errorf("%s:\ngot  %+v\nwant %+v", test.name, diff.Changes, test.changes)
+		}
+		if diff.IsEmpty() != (len(test.changes) == 0) {
+			t.Errorf("%s: IsEmpty() = %v", test.name, diff.IsEmpty())
+		}
+	}
+}
+
+func TestVMConfigDiffString(t *testing.T) {
+	diff := &VMConfigDiff{Changes: []VMConfigChange{
+		{Key: "scsi0", Type: VMConfigChangeChanged, Old: "local-lvm", New: "ceph", DiskOperation: VMDiskOperationMove},
+		{Key: "scsi0", Type: VMConfigChangeChanged, Old: "32G", New: "40G", DiskOperation: VMDiskOperationResize},
+		{Key: "balloon", Type: VMConfigChangeChanged, Old: "1024", New: "2048", Hotplug: true},
+		{Key: "sockets", Type: VMConfigChangeAdded, New: "1", RequiresReboot: true},
+		{Key: "onboot", Type: VMConfigChangeRemoved, Old: "1"},
+	}}
+	want := "~ scsi0: move from storage local-lvm to ceph\n" +
+		"~ scsi0: resize 32G => 40G\n" +
+		"~ balloon: 1024 => 2048 (hotplug)\n" +
+		"+ sockets: 1 (reboot required)\n" +
+		"- onboot\n" +
+		"Plan: 1 to add, 1 to change, 1 to remove, 2 disk operations.\n"
+	if s := diff.String(); s != want {
+		t.Errorf("got\n%s\nwant\n%s", s, want)
+	}
+	if !diff.RequiresReboot() {
+		t.Error("RequiresReboot() = false, want true")
+	}
+}
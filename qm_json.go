@@ -0,0 +1,385 @@
+package goproxmox
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Default keys of the property strings held by the raw QMOption maps. Their values are (un)marshaled as objects,
+// options without a default key (parallel) as plain strings.
+var rawQMOptionDefaultKeys = map[string]string{
+	parameterNUMATopologies: "cpus",
+	parameterSATADevices:    "file",
+	parameterSCSIDevices:    "file",
+	parameterUSBDevices:     "host",
+}
+
+var (
+	jsonIndexedKeyRegexp = regexp.MustCompile(`^([a-z_]+)(\d+)$`)
+	textUnmarshalerType  = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// vmConfigJSONField describes how a VMConfig field is named in JSON.
+// Indexed fields are device maps that are flattened into one key per device, e.g. net0, net1.
+type vmConfigJSONField struct {
+	Index   int
+	Name    string
+	Indexed bool
+}
+
+func vmConfigJSONFields() []vmConfigJSONField {
+	t := reflect.TypeOf(VMConfig{})
+	fields := make([]vmConfigJSONField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		field := vmConfigJSONField{Index: i, Name: name}
+		if strings.HasSuffix(name, "[n]") {
+			field.Name = strings.TrimSuffix(name, "[n]")
+			field.Indexed = true
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Keys are the Proxmox option names, devices are objects and options unknown to this library are strings.
+func (c *VMConfig) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+	for k, v := range c.Unknown {
+		m[k] = v
+	}
+
+	s := reflect.ValueOf(c).Elem()
+	for _, f := range vmConfigJSONFields() {
+		field := s.Field(f.Index)
+		if field.IsNil() {
+			continue
+		}
+		if !f.Indexed {
+			m[f.Name] = field.Interface()
+			continue
+		}
+		for _, number := range field.MapKeys() {
+			key := fmt.Sprintf("%s%d", f.Name, number.Int())
+			value := field.MapIndex(number).Interface()
+			if option, ok := value.(QMOption); ok && field.Type().Elem().Kind() == reflect.Interface {
+				m[key] = rawQMOptionToJSON(f.Name, option)
+			} else {
+				m[key] = value
+			}
+		}
+	}
+
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Keys that don't belong to a VMConfig field
+// are stored in Unknown.
+func (c *VMConfig) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	// numa and numa[n] share their name, so scalar and indexed fields are looked up separately
+	fields := make(map[string]vmConfigJSONField)
+	indexedFields := make(map[string]vmConfigJSONField)
+	for _, f := range vmConfigJSONFields() {
+		if f.Indexed {
+			indexedFields[f.Name] = f
+		} else {
+			fields[f.Name] = f
+		}
+	}
+
+	// Values given as Proxmox property strings (e.g. "net0": "virtio,bridge=vmbr0") are decoded like API responses.
+	native := make(map[string]interface{})
+	for key, value := range raw {
+		var str string
+		if t := vmConfigJSONFieldType(fields, indexedFields, key); t != nil && isNativeJSONValue(t) && json.Unmarshal(value, &str) == nil {
+			native[key] = str
+			delete(raw, key)
+		}
+	}
+
+	config := NewVMConfigFromMap(native)
+	s := reflect.ValueOf(config).Elem()
+	for key, value := range raw {
+		if f, ok := fields[key]; ok {
+			if err := json.Unmarshal(value, s.Field(f.Index).Addr().Interface()); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+			continue
+		}
+
+		matchResults := jsonIndexedKeyRegexp.FindStringSubmatch(key)
+		if matchResults != nil {
+			if f, ok := indexedFields[matchResults[1]]; ok {
+				number, _ := strconv.Atoi(matchResults[2])
+				field := s.Field(f.Index)
+				if field.IsNil() {
+					field.Set(reflect.MakeMap(field.Type()))
+				}
+				element, err := unmarshalJSONDevice(f.Name, field.Type().Elem(), value)
+				if err != nil {
+					return fmt.Errorf("%s: %v", key, err)
+				}
+				field.SetMapIndex(reflect.ValueOf(number), element)
+				continue
+			}
+		}
+
+		config.addUnknown(key, jsonRawToString(value))
+	}
+
+	*c = *config
+	return nil
+}
+
+// vmConfigJSONFieldType returns the type of the VMConfig field or device that key is decoded into.
+func vmConfigJSONFieldType(fields, indexedFields map[string]vmConfigJSONField, key string) reflect.Type {
+	t := reflect.TypeOf(VMConfig{})
+	if f, ok := fields[key]; ok {
+		return t.Field(f.Index).Type
+	}
+	if matchResults := jsonIndexedKeyRegexp.FindStringSubmatch(key); matchResults != nil {
+		if f, ok := indexedFields[matchResults[1]]; ok {
+			return t.Field(f.Index).Type.Elem()
+		}
+	}
+	return nil
+}
+
+// isNativeJSONValue reports whether a JSON string can't be decoded into t and must be parsed as Proxmox value instead.
+func isNativeJSONValue(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface || reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return false
+	}
+	if t.Kind() == reflect.Ptr {
+		if t.Implements(textUnmarshalerType) {
+			return false
+		}
+		t = t.Elem()
+	}
+	return t.Kind() != reflect.String
+}
+
+func unmarshalJSONDevice(name string, t reflect.Type, data json.RawMessage) (reflect.Value, error) {
+	if t.Kind() == reflect.Interface {
+		var value string
+		if err := json.Unmarshal(data, &value); err == nil {
+			return reflect.ValueOf(NewRawQMOption(value)), nil
+		}
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return reflect.Value{}, err
+		}
+		options := make(map[string]string, len(raw))
+		for k, v := range raw {
+			options[k] = jsonRawToString(v)
+		}
+		return reflect.ValueOf(NewRawQMOption(formatQMOptionValue(options, rawQMOptionDefaultKeys[name]))), nil
+	}
+
+	element := reflect.New(t.Elem())
+	if err := json.Unmarshal(data, element.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+	return element, nil
+}
+
+// rawQMOptionToJSON returns the property string of option as key/value pairs, or as string if it has no keys.
+func rawQMOptionToJSON(name string, option QMOption) interface{} {
+	value := option.GetQMOptionValue()
+	defaultKey, ok := rawQMOptionDefaultKeys[name]
+	if !ok {
+		return value
+	}
+	return parseQMOptionValue(value, defaultKey)
+}
+
+// jsonRawToString returns JSON strings unquoted, booleans as "1"/"0" and everything else verbatim.
+func jsonRawToString(data json.RawMessage) string {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return string(data)
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return boolToString(v)
+	case float64:
+		return interfaceToString(v)
+	}
+	return string(data)
+}
+
+// MarshalYAML implements the Marshaler interface of gopkg.in/yaml.v2 and v3.
+// The YAML document has the same structure as the JSON one.
+func (c *VMConfig) MarshalYAML() (interface{}, error) {
+	data, err := c.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+// UnmarshalYAML implements the Unmarshaler interface of gopkg.in/yaml.v2 and v3.
+func (c *VMConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value map[string]interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	data, err := json.Marshal(yamlToJSONValue(value))
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalJSON(data)
+}
+
+// yamlToJSONValue converts the map[interface{}]interface{} values produced by YAML decoders
+// into map[string]interface{} so they can be encoded as JSON.
+func yamlToJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprintf("%v", k)] = yamlToJSONValue(e)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = yamlToJSONValue(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = yamlToJSONValue(e)
+		}
+		return s
+	}
+	return value
+}
+
+// marshalJSONWithOptions marshals v and adds the unmodelled options as additional keys.
+func marshalJSONWithOptions(v interface{}, options map[string]string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(options) == 0 {
+		return data, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, value := range options {
+		if _, ok := m[k]; !ok {
+			m[k] = value
+		}
+	}
+	return json.Marshal(m)
+}
+
+// unmarshalJSONWithOptions unmarshals data into v and returns the keys that don't belong to a field of v.
+func unmarshalJSONWithOptions(data []byte, v interface{}) (map[string]string, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		known[strings.Split(t.Field(i).Tag.Get("json"), ",")[0]] = true
+	}
+
+	var options map[string]string
+	for k, value := range raw {
+		if known[k] {
+			continue
+		}
+		if options == nil {
+			options = make(map[string]string)
+		}
+		options[k] = jsonRawToString(value)
+	}
+	return options, nil
+}
+
+type networkDeviceJSON NetworkDevice
+
+// MarshalJSON implements the json.Marshaler interface. Options are added as additional keys.
+func (c *NetworkDevice) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithOptions((*networkDeviceJSON)(c), c.Options)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown keys are stored in Options.
+func (c *NetworkDevice) UnmarshalJSON(data []byte) error {
+	options, err := unmarshalJSONWithOptions(data, (*networkDeviceJSON)(c))
+	c.Options = options
+	return err
+}
+
+type virtIODeviceJSON VirtIODevice
+
+// MarshalJSON implements the json.Marshaler interface. Options are added as additional keys.
+func (c *VirtIODevice) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithOptions((*virtIODeviceJSON)(c), c.Options)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown keys are stored in Options.
+func (c *VirtIODevice) UnmarshalJSON(data []byte) error {
+	options, err := unmarshalJSONWithOptions(data, (*virtIODeviceJSON)(c))
+	c.Options = options
+	return err
+}
+
+type ideDeviceJSON IDEDevice
+
+// MarshalJSON implements the json.Marshaler interface. Options are added as additional keys.
+func (c *IDEDevice) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithOptions((*ideDeviceJSON)(c), c.Options)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown keys are stored in Options.
+func (c *IDEDevice) UnmarshalJSON(data []byte) error {
+	options, err := unmarshalJSONWithOptions(data, (*ideDeviceJSON)(c))
+	c.Options = options
+	return err
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c SerialDevice) MarshalText() ([]byte, error) { return []byte(c.Value), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *SerialDevice) UnmarshalText(text []byte) error {
+	c.Value = string(text)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. Flags are written as "+FLAG" or "-FLAG".
+func (f CPUFlag) MarshalText() ([]byte, error) { return []byte(f.String()), nil }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. A flag without sign is enabled.
+func (f *CPUFlag) UnmarshalText(text []byte) error {
+	s := string(text)
+	f.Enabled = !strings.HasPrefix(s, "-")
+	f.Name = strings.TrimLeft(s, "+-")
+	return nil
+}
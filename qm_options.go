@@ -1,7 +1,11 @@
 package goproxmox
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -22,48 +26,97 @@ func (c *rawQMOption) GetQMOptionValue() string {
 	return c.value
 }
 
+// parseQMOptionValue splits a property string like "local:100/disk.raw,size=4G" into its key/value pairs.
+// A leading value without a key is stored under defaultKey.
+func parseQMOptionValue(value, defaultKey string) map[string]string {
+	options := make(map[string]string)
+	for i, option := range strings.Split(value, ",") {
+		if option == "" {
+			continue
+		}
+		optionParts := strings.SplitN(option, "=", 2)
+		if len(optionParts) == 2 {
+			options[optionParts[0]] = optionParts[1]
+		} else if i == 0 && defaultKey != "" {
+			options[defaultKey] = option
+		}
+	}
+	return options
+}
+
+// formatQMOptionValue is the inverse of parseQMOptionValue. The default key is written first, without key.
+func formatQMOptionValue(options map[string]string, defaultKey string) string {
+	v := make([]string, 0, len(options))
+	if value, ok := options[defaultKey]; ok {
+		v = append(v, value)
+	}
+	for _, k := range sortedKeys(options) {
+		if k != defaultKey {
+			v = append(v, fmt.Sprintf("%s=%s", k, options[k]))
+		}
+	}
+	return strings.Join(v, ",")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // Network device
 
 type NetworkDevice struct {
 	// Network Card Model. The virtio model provides the best performance with very low CPU overhead.
 	// If your guest does not support this driver, it is usually best to use e1000.
-	Model *NetworkCardModel
+	Model *NetworkCardModel `json:"model,omitempty"`
 
 	// Bridge to attach the network device to. The Proxmox VE standard bridge is called vmbr0.
-	Bridge *string
+	Bridge *string `json:"bridge,omitempty"`
 
 	// Whether this interface should be protected by the firewall.
-	Firewall *bool
+	Firewall *bool `json:"firewall,omitempty"`
 
 	// Whether this interface should be disconnected (like pulling the plug).
-	LinkDown *bool
+	LinkDown *bool `json:"link_down,omitempty"`
 
 	// <XX:XX:XX:XX:XX:XX> MAC address.
 	// That address must be unique withing your network. This is automatically generated if not specified.
-	MacAddr *string
+	MacAddr *string `json:"macaddr,omitempty"`
 
 	// (0 - 16) Number of packet queues to be used on the device.
-	Queues *int
+	Queues *int `json:"queues,omitempty"`
 
 	// (0 - N) Rate limit in mbps (megabytes per second) as floating point number.
-	Rate *float64
+	Rate *float64 `json:"rate,omitempty"`
 
 	// (1 - 4094) VLAN tag to apply to packets on this interface.
-	Tag *int
+	Tag *int `json:"tag,omitempty"`
 
 	// <vlanid[;vlanid...]> VLAN trunks to pass through this interface.
-	Trunks *string
+	Trunks *string `json:"trunks,omitempty"`
+
+	// Options not modelled by this type (e.g. mtu). They are kept verbatim.
+	Options map[string]string `json:"-"`
 }
 
 func NewNetworkDeviceFromString(value string) *NetworkDevice {
 	d := &NetworkDevice{}
 	options := strings.Split(value, ",")
-	for _, option := range options {
-		optionParts := strings.Split(option, "=")
+	for i, option := range options {
+		optionParts := strings.SplitN(option, "=", 2)
 		if len(optionParts) == 2 {
 			k := optionParts[0]
 			v := optionParts[1]
 			switch k {
+			case "model":
+				model, _ := NetworkCardModelFromString(v)
+				d.Model = &model
+			case "macaddr":
+				d.MacAddr = String(v)
 			case "bridge":
 				d.Bridge = String(v)
 			case "firewall":
@@ -74,168 +127,199 @@ func NewNetworkDeviceFromString(value string) *NetworkDevice {
 				val, _ := strconv.Atoi(v)
 				d.Queues = Int(val)
 			case "rate":
-				val, _ := strconv.ParseFloat(v, 10)
+				val, _ := strconv.ParseFloat(v, 64)
 				d.Rate = Float64(val)
 			case "tag":
 				val, _ := strconv.Atoi(v)
 				d.Tag = Int(val)
 			case "trunks":
 				d.Trunks = String(v)
+			default:
+				// The first option may be <model>=<macaddr>, e.g. "virtio=62:C7:1E:16:D5:C4".
+				if i == 0 {
+					model, _ := NetworkCardModelFromString(k)
+					d.Model = &model
+					d.MacAddr = String(v)
+				} else {
+					d.addOption(k, v)
+				}
 			}
+		} else if i == 0 && option != "" {
+			model, _ := NetworkCardModelFromString(option)
+			d.Model = &model
 		}
 	}
-	optionParts := strings.Split(options[0], "=")
-	v, _ := NetworkCardModelFromString(optionParts[0])
-	d.Model = &v
-	d.MacAddr = String(optionParts[1])
 
 	return d
 }
 
 func (c *NetworkDevice) GetQMOptionValue() string {
-	v := make([]string, 0, 1)
-	if c.Model != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "model", c.Model.String()))
+	options := make(map[string]string)
+	for k, v := range c.Options {
+		options[k] = v
 	}
 	if c.Bridge != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "bridge", *c.Bridge))
+		options["bridge"] = *c.Bridge
 	}
 	if c.Firewall != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "firewall", boolToString(*c.Firewall)))
+		options["firewall"] = boolToString(*c.Firewall)
 	}
 	if c.LinkDown != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "link_down", boolToString(*c.LinkDown)))
-	}
-	if c.MacAddr != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "macaddr", *c.MacAddr))
+		options["link_down"] = boolToString(*c.LinkDown)
 	}
 	if c.Queues != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "queues", *c.Queues))
+		options["queues"] = strconv.Itoa(*c.Queues)
 	}
 	if c.Rate != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "rate", *c.Rate))
+		options["rate"] = strconv.FormatFloat(*c.Rate, 'f', -1, 64)
 	}
 	if c.Tag != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "tag", *c.Tag))
+		options["tag"] = strconv.Itoa(*c.Tag)
 	}
 	if c.Trunks != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "trunks", *c.Trunks))
+		options["trunks"] = *c.Trunks
 	}
-	return strings.Join(v, ",")
+	// Proxmox writes the model first, followed by the MAC address: "virtio=62:C7:1E:16:D5:C4"
+	model := ""
+	if c.Model != nil {
+		model = c.Model.String()
+		if c.MacAddr != nil {
+			model += "=" + *c.MacAddr
+		}
+		options["model"] = model
+	} else if c.MacAddr != nil {
+		options["macaddr"] = *c.MacAddr
+	}
+	return formatQMOptionValue(options, "model")
+}
+
+func (c *NetworkDevice) addOption(key, value string) {
+	if c.Options == nil {
+		c.Options = make(map[string]string)
+	}
+	c.Options[key] = value
 }
 
 // VirtIO device
 
 type VirtIODevice struct {
-	File     *string
-	Format   *VolumeFormat
-	Backup   *bool
-	IOThread *bool
-	Size     *string
-	Snapshot *bool
+	File     *string       `json:"file,omitempty"`
+	Format   *VolumeFormat `json:"format,omitempty"`
+	Backup   *bool         `json:"backup,omitempty"`
+	IOThread *bool         `json:"iothread,omitempty"`
+	Size     *string       `json:"size,omitempty"`
+	Snapshot *bool         `json:"snapshot,omitempty"`
+
+	// Options not modelled by this type (e.g. cache or discard). They are kept verbatim.
+	Options map[string]string `json:"-"`
 }
 
 func NewVirtIODeviceFromString(value string) *VirtIODevice {
 	d := &VirtIODevice{}
-	options := strings.Split(value, ",")
-	for _, option := range options {
-		optionParts := strings.Split(option, "=")
-		if len(optionParts) == 2 {
-			k := optionParts[0]
-			v := optionParts[1]
-			switch k {
-			case "file":
-				d.File = String(v)
-			case "format":
-				format, _ := VolumeFormatFromString(v)
-				d.Format = &format
-			case "backup":
-				d.Backup = Bool(stringToBool(v))
-			case "iothread":
-				d.IOThread = Bool(stringToBool(v))
-			case "size":
-				d.Size = String(v)
-			case "snapshot":
-				d.Snapshot = Bool(stringToBool(v))
+	for k, v := range parseQMOptionValue(value, "file") {
+		switch k {
+		case "file":
+			d.File = String(v)
+		case "format":
+			format, _ := VolumeFormatFromString(v)
+			d.Format = &format
+		case "backup":
+			d.Backup = Bool(stringToBool(v))
+		case "iothread":
+			d.IOThread = Bool(stringToBool(v))
+		case "size":
+			d.Size = String(v)
+		case "snapshot":
+			d.Snapshot = Bool(stringToBool(v))
+		default:
+			if d.Options == nil {
+				d.Options = make(map[string]string)
 			}
+			d.Options[k] = v
 		}
 	}
-	d.File = String(options[0])
 
 	return d
 }
 
 func (c *VirtIODevice) GetQMOptionValue() string {
-	v := make([]string, 0, 1)
+	options := make(map[string]string)
+	for k, v := range c.Options {
+		options[k] = v
+	}
 	if c.File != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "file", *c.File))
+		options["file"] = *c.File
 	}
 	if c.Format != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "format", c.Format.String()))
+		options["format"] = c.Format.String()
 	}
 	if c.Backup != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "backup", boolToString(*c.Backup)))
+		options["backup"] = boolToString(*c.Backup)
 	}
 	if c.IOThread != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "iothread", boolToString(*c.IOThread)))
+		options["iothread"] = boolToString(*c.IOThread)
 	}
 	if c.Size != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "size", *c.Size))
+		options["size"] = *c.Size
 	}
 	if c.Snapshot != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "snapshot", boolToString(*c.Snapshot)))
+		options["snapshot"] = boolToString(*c.Snapshot)
 	}
-	return strings.Join(v, ",")
+	return formatQMOptionValue(options, "file")
 }
 
 // IDE device
 type IDEDevice struct {
-	File  *string
-	Media *MediaType
-	Size  *string
+	File  *string    `json:"file,omitempty"`
+	Media *MediaType `json:"media,omitempty"`
+	Size  *string    `json:"size,omitempty"`
+
+	// Options not modelled by this type (e.g. cache or discard). They are kept verbatim.
+	Options map[string]string `json:"-"`
 }
 
 func NewIDEDeviceFromString(value string) *IDEDevice {
 	d := &IDEDevice{}
-	options := strings.Split(value, ",")
-	for _, option := range options {
-		optionParts := strings.Split(option, "=")
-		if len(optionParts) == 2 {
-			k := optionParts[0]
-			v := optionParts[1]
-			switch k {
-			case "file":
-				d.File = String(v)
-			case "media":
-				media, _ := MediaTypeFromString(v)
-				d.Media = &media
-			case "size":
-				d.Size = String(v)
+	for k, v := range parseQMOptionValue(value, "file") {
+		switch k {
+		case "file":
+			d.File = String(v)
+		case "media":
+			media, _ := MediaTypeFromString(v)
+			d.Media = &media
+		case "size":
+			d.Size = String(v)
+		default:
+			if d.Options == nil {
+				d.Options = make(map[string]string)
 			}
+			d.Options[k] = v
 		}
 	}
-	d.File = String(options[0])
 
 	return d
 }
 
 func (c *IDEDevice) GetQMOptionValue() string {
-	v := make([]string, 0, 1)
+	options := make(map[string]string)
+	for k, v := range c.Options {
+		options[k] = v
+	}
 	if c.File != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "file", *c.File))
+		options["file"] = *c.File
 	}
 	if c.Media != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "media", c.Media.String()))
+		options["media"] = c.Media.String()
 	}
 	if c.Size != nil {
-		v = append(v, fmt.Sprintf("%s=%s", "size", *c.Size))
+		options["size"] = *c.Size
 	}
-	return strings.Join(v, ",")
+	return formatQMOptionValue(options, "file")
 }
 
 // Serial device
 type SerialDevice struct {
-	Value  string
+	Value string
 }
 
 func NewSerialDeviceFromString(value string) *SerialDevice {
@@ -245,3 +329,701 @@ func NewSerialDeviceFromString(value string) *SerialDevice {
 func (c *SerialDevice) GetQMOptionValue() string {
 	return c.Value
 }
+
+// Machine type
+type Machine struct {
+	// Specifies the QEMU machine type, e.g. q35, pc-q35-8.1 or pc-i440fx-7.2+pve0.
+	Type *string `json:"type,omitempty"`
+
+	// Enable and set guest vIOMMU variant. The intel variant needs q35 to be set as machine type.
+	VIOMMU *VIOMMU `json:"viommu,omitempty"`
+}
+
+var machineVersionRegexp = regexp.MustCompile(`^pc(?:-(?:q35|i440fx))?-(\d+\.\d+)(?:\+pve\d+)?(?:\.pxe)?$`)
+
+func NewMachineFromString(value string) *Machine {
+	d := &Machine{}
+	for k, v := range parseQMOptionValue(value, "type") {
+		switch k {
+		case "type":
+			d.Type = String(v)
+		case "viommu":
+			viommu, _ := VIOMMUFromString(v)
+			d.VIOMMU = &viommu
+		}
+	}
+	return d
+}
+
+// IsQ35 reports whether the machine type uses the Q35 chipset.
+func (c *Machine) IsQ35() bool {
+	t := StringValue(c.Type)
+	return t == "q35" || strings.HasPrefix(t, "pc-q35-")
+}
+
+// Version returns the pinned QEMU machine version (e.g. "8.1"), or "" if the latest version is used.
+func (c *Machine) Version() string {
+	matchResults := machineVersionRegexp.FindStringSubmatch(StringValue(c.Type))
+	if len(matchResults) > 1 {
+		return matchResults[1]
+	}
+	return ""
+}
+
+func (c *Machine) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Type != nil {
+		v = append(v, *c.Type)
+	}
+	if c.VIOMMU != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "viommu", c.VIOMMU.String()))
+	}
+	return strings.Join(v, ",")
+}
+
+// EFI disk
+type EFIDisk struct {
+	// The drive's backing volume. Use STORAGE_ID:0 to allocate a new EFI vars disk.
+	File *string `json:"file,omitempty"`
+
+	// Size and type of the OVMF EFI vars. '4m' is newer and recommended, and required for Secure Boot.
+	EFIType *EFIType `json:"efitype,omitempty"`
+
+	Format *VolumeFormat `json:"format,omitempty"`
+
+	// Use an EFI vars template with distribution-specific and Microsoft Standard keys enrolled,
+	// if used with efitype=4m. This enables Secure Boot by default.
+	PreEnrolledKeys *bool `json:"pre-enrolled-keys,omitempty"`
+
+	Size *string `json:"size,omitempty"`
+}
+
+func NewEFIDiskFromString(value string) *EFIDisk {
+	d := &EFIDisk{}
+	for k, v := range parseQMOptionValue(value, "file") {
+		switch k {
+		case "file":
+			d.File = String(v)
+		case "efitype":
+			efiType, _ := EFITypeFromString(v)
+			d.EFIType = &efiType
+		case "format":
+			format, _ := VolumeFormatFromString(v)
+			d.Format = &format
+		case "pre-enrolled-keys":
+			d.PreEnrolledKeys = Bool(stringToBool(v))
+		case "size":
+			d.Size = String(v)
+		}
+	}
+	return d
+}
+
+func (c *EFIDisk) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.File != nil {
+		v = append(v, *c.File)
+	}
+	if c.EFIType != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "efitype", c.EFIType.String()))
+	}
+	if c.Format != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "format", c.Format.String()))
+	}
+	if c.PreEnrolledKeys != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "pre-enrolled-keys", boolToString(*c.PreEnrolledKeys)))
+	}
+	if c.Size != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "size", *c.Size))
+	}
+	return strings.Join(v, ",")
+}
+
+// TPM state
+type TPMState struct {
+	// The drive's backing volume. Use STORAGE_ID:0 to allocate a new TPM state disk.
+	File *string `json:"file,omitempty"`
+
+	Size *string `json:"size,omitempty"`
+
+	// The TPM interface version. v2.0 is newer and should be preferred.
+	Version *TPMVersion `json:"version,omitempty"`
+}
+
+func NewTPMStateFromString(value string) *TPMState {
+	d := &TPMState{}
+	for k, v := range parseQMOptionValue(value, "file") {
+		switch k {
+		case "file":
+			d.File = String(v)
+		case "size":
+			d.Size = String(v)
+		case "version":
+			version, _ := TPMVersionFromString(v)
+			d.Version = &version
+		}
+	}
+	return d
+}
+
+func (c *TPMState) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.File != nil {
+		v = append(v, *c.File)
+	}
+	if c.Size != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "size", *c.Size))
+	}
+	if c.Version != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "version", c.Version.String()))
+	}
+	return strings.Join(v, ",")
+}
+
+// VirtIO RNG device
+type RNGDevice struct {
+	// The file on the host to gather entropy from.
+	Source *RNGSource `json:"source,omitempty"`
+
+	// Maximum bytes of entropy allowed to get injected into the guest every 'period' milliseconds.
+	// Use 0 to disable limiting.
+	// default = 1024
+	MaxBytes *int `json:"max_bytes,omitempty"`
+
+	// Every 'period' milliseconds the entropy-injection quota is reset.
+	// default = 1000
+	Period *int `json:"period,omitempty"`
+}
+
+func NewRNGDeviceFromString(value string) *RNGDevice {
+	d := &RNGDevice{}
+	for k, v := range parseQMOptionValue(value, "source") {
+		switch k {
+		case "source":
+			source, _ := RNGSourceFromString(v)
+			d.Source = &source
+		case "max_bytes":
+			val, _ := strconv.Atoi(v)
+			d.MaxBytes = Int(val)
+		case "period":
+			val, _ := strconv.Atoi(v)
+			d.Period = Int(val)
+		}
+	}
+	return d
+}
+
+func (c *RNGDevice) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Source != nil {
+		v = append(v, c.Source.String())
+	}
+	if c.MaxBytes != nil {
+		v = append(v, fmt.Sprintf("%s=%d", "max_bytes", *c.MaxBytes))
+	}
+	if c.Period != nil {
+		v = append(v, fmt.Sprintf("%s=%d", "period", *c.Period))
+	}
+	return strings.Join(v, ",")
+}
+
+// Audio device
+type AudioDevice struct {
+	// Configure an audio device.
+	Device *AudioDeviceModel `json:"device,omitempty"`
+
+	// Driver backend for the audio device.
+	// default = spice
+	Driver *AudioDriver `json:"driver,omitempty"`
+}
+
+func NewAudioDeviceFromString(value string) *AudioDevice {
+	d := &AudioDevice{}
+	for k, v := range parseQMOptionValue(value, "device") {
+		switch k {
+		case "device":
+			device, _ := AudioDeviceModelFromString(v)
+			d.Device = &device
+		case "driver":
+			driver, _ := AudioDriverFromString(v)
+			d.Driver = &driver
+		}
+	}
+	return d
+}
+
+func (c *AudioDevice) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Device != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "device", c.Device.String()))
+	}
+	if c.Driver != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "driver", c.Driver.String()))
+	}
+	return strings.Join(v, ",")
+}
+
+// Qemu Guest Agent
+type QemuAgentOptions struct {
+	// Enable/disable communication with a Qemu Guest Agent (QGA) running in the VM.
+	// default = 0
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Freeze/thaw guest filesystems on backup for consistency.
+	// default = 1
+	FreezeFSOnBackup *bool `json:"freeze-fs-on-backup,omitempty"`
+
+	// Run fstrim after moving a disk or migrating the VM.
+	// default = 0
+	FstrimClonedDisks *bool `json:"fstrim_cloned_disks,omitempty"`
+
+	// Select the agent type.
+	// default = virtio
+	Type *QemuAgentType `json:"type,omitempty"`
+}
+
+func NewQemuAgentOptionsFromString(value string) *QemuAgentOptions {
+	d := &QemuAgentOptions{}
+	for k, v := range parseQMOptionValue(value, "enabled") {
+		switch k {
+		case "enabled":
+			d.Enabled = Bool(stringToBool(v))
+		case "freeze-fs-on-backup":
+			d.FreezeFSOnBackup = Bool(stringToBool(v))
+		case "fstrim_cloned_disks":
+			d.FstrimClonedDisks = Bool(stringToBool(v))
+		case "type":
+			agentType, _ := QemuAgentTypeFromString(v)
+			d.Type = &agentType
+		}
+	}
+	return d
+}
+
+func (c *QemuAgentOptions) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Enabled != nil {
+		v = append(v, boolToString(*c.Enabled))
+	}
+	if c.FreezeFSOnBackup != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "freeze-fs-on-backup", boolToString(*c.FreezeFSOnBackup)))
+	}
+	if c.FstrimClonedDisks != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "fstrim_cloned_disks", boolToString(*c.FstrimClonedDisks)))
+	}
+	if c.Type != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "type", c.Type.String()))
+	}
+	return strings.Join(v, ",")
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Besides an object it accepts a boolean for Enabled.
+func (c *QemuAgentOptions) UnmarshalJSON(data []byte) error {
+	var enabled bool
+	if err := json.Unmarshal(data, &enabled); err == nil {
+		*c = QemuAgentOptions{Enabled: Bool(enabled)}
+		return nil
+	}
+	type plain QemuAgentOptions
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// Inter-VM shared memory
+type IVShmem struct {
+	// The size of the file in MB.
+	Size *int `json:"size,omitempty"`
+
+	// The name of the file. Will be prefixed with 'pve-shm-'. Default is the VMID.
+	Name *string `json:"name,omitempty"`
+}
+
+func NewIVShmemFromString(value string) *IVShmem {
+	d := &IVShmem{}
+	for k, v := range parseQMOptionValue(value, "size") {
+		switch k {
+		case "size":
+			val, _ := strconv.Atoi(v)
+			d.Size = Int(val)
+		case "name":
+			d.Name = String(v)
+		}
+	}
+	return d
+}
+
+func (c *IVShmem) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Size != nil {
+		v = append(v, fmt.Sprintf("%s=%d", "size", *c.Size))
+	}
+	if c.Name != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "name", *c.Name))
+	}
+	return strings.Join(v, ",")
+}
+
+// SPICE enhancements
+type SpiceEnhancements struct {
+	// Enable folder sharing via SPICE. Needs Spice-WebDAV daemon installed in the VM.
+	FolderSharing *bool `json:"foldersharing,omitempty"`
+
+	// Enable video streaming. Uses compression for detected video streams.
+	VideoStreaming *VideoStreaming `json:"videostreaming,omitempty"`
+}
+
+func NewSpiceEnhancementsFromString(value string) *SpiceEnhancements {
+	d := &SpiceEnhancements{}
+	for k, v := range parseQMOptionValue(value, "") {
+		switch k {
+		case "foldersharing":
+			d.FolderSharing = Bool(stringToBool(v))
+		case "videostreaming":
+			videoStreaming, _ := VideoStreamingFromString(v)
+			d.VideoStreaming = &videoStreaming
+		}
+	}
+	return d
+}
+
+func (c *SpiceEnhancements) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.FolderSharing != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "foldersharing", boolToString(*c.FolderSharing)))
+	}
+	if c.VideoStreaming != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "videostreaming", c.VideoStreaming.String()))
+	}
+	return strings.Join(v, ",")
+}
+
+// CPU options
+type CPUOptions struct {
+	// Emulated CPU type.
+	// default = kvm64
+	Type *CPUType `json:"cputype,omitempty"`
+
+	// List of additional CPU flags. Use '+FLAG' to enable, '-FLAG' to disable a flag.
+	Flags []CPUFlag `json:"flags,omitempty"`
+
+	// Do not identify as a KVM virtual machine.
+	// default = 0
+	Hidden *bool `json:"hidden,omitempty"`
+
+	// The Hyper-V vendor ID. Some drivers or programs inside Windows guests need a specific ID.
+	HVVendorID *string `json:"hv-vendor-id,omitempty"`
+
+	// (8 - 64 | host) The physical memory address bits that are reported to the guest OS.
+	PhysBits *string `json:"phys-bits,omitempty"`
+
+	// CPU model and vendor to report to the guest. Must be a QEMU/KVM supported model.
+	ReportedModel *CPUType `json:"reported-model,omitempty"`
+}
+
+type CPUFlag struct {
+	Name    string
+	Enabled bool
+}
+
+func (f CPUFlag) String() string {
+	if f.Enabled {
+		return "+" + f.Name
+	}
+	return "-" + f.Name
+}
+
+func NewCPUOptionsFromString(value string) *CPUOptions {
+	d := &CPUOptions{}
+	for k, v := range parseQMOptionValue(value, "cputype") {
+		switch k {
+		case "cputype":
+			cpuType, _ := CPUTypeFromString(v)
+			d.Type = &cpuType
+		case "flags":
+			for _, flag := range strings.Split(v, ";") {
+				if len(flag) < 2 {
+					continue
+				}
+				d.Flags = append(d.Flags, CPUFlag{Name: flag[1:], Enabled: flag[0] != '-'})
+			}
+		case "hidden":
+			d.Hidden = Bool(stringToBool(v))
+		case "hv-vendor-id":
+			d.HVVendorID = String(v)
+		case "phys-bits":
+			d.PhysBits = String(v)
+		case "reported-model":
+			reportedModel, _ := CPUTypeFromString(v)
+			d.ReportedModel = &reportedModel
+		}
+	}
+	return d
+}
+
+func (c *CPUOptions) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Type != nil {
+		v = append(v, c.Type.String())
+	}
+	if len(c.Flags) > 0 {
+		flags := make([]string, len(c.Flags))
+		for i, flag := range c.Flags {
+			flags[i] = flag.String()
+		}
+		v = append(v, fmt.Sprintf("%s=%s", "flags", strings.Join(flags, ";")))
+	}
+	if c.Hidden != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "hidden", boolToString(*c.Hidden)))
+	}
+	if c.HVVendorID != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "hv-vendor-id", *c.HVVendorID))
+	}
+	if c.PhysBits != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "phys-bits", *c.PhysBits))
+	}
+	if c.ReportedModel != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "reported-model", c.ReportedModel.String()))
+	}
+	return strings.Join(v, ",")
+}
+
+// SMBIOS type 1 fields
+type SMBIOS1 struct {
+	// Flag to indicate that the SMBIOS values are base64 encoded.
+	// Values that can't be represented in a property string are always encoded.
+	Base64 *bool `json:"base64,omitempty"`
+
+	Family       *string `json:"family,omitempty"`
+	Manufacturer *string `json:"manufacturer,omitempty"`
+	Product      *string `json:"product,omitempty"`
+	Serial       *string `json:"serial,omitempty"`
+	SKU          *string `json:"sku,omitempty"`
+
+	// Set SMBIOS1 UUID. It is never base64 encoded.
+	UUID *string `json:"uuid,omitempty"`
+
+	Version *string `json:"version,omitempty"`
+}
+
+var smbios1PlainValueRegexp = regexp.MustCompile(`^[\w\-. ]*$`)
+
+func NewSMBIOS1FromString(value string) *SMBIOS1 {
+	d := &SMBIOS1{}
+	options := parseQMOptionValue(value, "")
+	encoded := stringToBool(options["base64"])
+	decode := func(v string) *string {
+		if encoded {
+			if decoded, err := base64.StdEncoding.DecodeString(v); err == nil {
+				return String(string(decoded))
+			}
+		}
+		return String(v)
+	}
+	for k, v := range options {
+		switch k {
+		case "base64":
+			d.Base64 = Bool(encoded)
+		case "family":
+			d.Family = decode(v)
+		case "manufacturer":
+			d.Manufacturer = decode(v)
+		case "product":
+			d.Product = decode(v)
+		case "serial":
+			d.Serial = decode(v)
+		case "sku":
+			d.SKU = decode(v)
+		case "uuid":
+			d.UUID = String(v)
+		case "version":
+			d.Version = decode(v)
+		}
+	}
+	return d
+}
+
+func (c *SMBIOS1) GetQMOptionValue() string {
+	values := []struct {
+		key       string
+		value     *string
+		encodable bool
+	}{
+		{"family", c.Family, true},
+		{"manufacturer", c.Manufacturer, true},
+		{"product", c.Product, true},
+		{"serial", c.Serial, true},
+		{"sku", c.SKU, true},
+		{"uuid", c.UUID, false},
+		{"version", c.Version, true},
+	}
+	encode := BoolValue(c.Base64)
+	for _, field := range values {
+		if field.encodable && field.value != nil && !smbios1PlainValueRegexp.MatchString(*field.value) {
+			encode = true
+		}
+	}
+
+	v := make([]string, 0, 1)
+	if encode || c.Base64 != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "base64", boolToString(encode)))
+	}
+	for _, field := range values {
+		if field.value == nil {
+			continue
+		}
+		value := *field.value
+		if encode && field.encodable {
+			value = base64.StdEncoding.EncodeToString([]byte(value))
+		}
+		v = append(v, fmt.Sprintf("%s=%s", field.key, value))
+	}
+	return strings.Join(v, ",")
+}
+
+// Startup and shutdown behavior
+type StartupOrder struct {
+	// Non-negative number defining the general startup order. Shutdown is done with reverse ordering.
+	Order *int `json:"order,omitempty"`
+
+	// Delay in seconds to wait before the next VM is started.
+	Up *int `json:"up,omitempty"`
+
+	// Delay in seconds to wait before the next VM is stopped.
+	Down *int `json:"down,omitempty"`
+}
+
+func NewStartupOrderFromString(value string) *StartupOrder {
+	d := &StartupOrder{}
+	for k, v := range parseQMOptionValue(value, "order") {
+		val, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		switch k {
+		case "order":
+			d.Order = Int(val)
+		case "up":
+			d.Up = Int(val)
+		case "down":
+			d.Down = Int(val)
+		}
+	}
+	return d
+}
+
+func (c *StartupOrder) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Order != nil {
+		v = append(v, fmt.Sprintf("%s=%d", "order", *c.Order))
+	}
+	if c.Up != nil {
+		v = append(v, fmt.Sprintf("%s=%d", "up", *c.Up))
+	}
+	if c.Down != nil {
+		v = append(v, fmt.Sprintf("%s=%d", "down", *c.Down))
+	}
+	return strings.Join(v, ",")
+}
+
+// Hotplug features
+type HotplugFeature string
+
+const (
+	HotplugNetwork   HotplugFeature = "network"
+	HotplugDisk      HotplugFeature = "disk"
+	HotplugCPU       HotplugFeature = "cpu"
+	HotplugMemory    HotplugFeature = "memory"
+	HotplugUSB       HotplugFeature = "usb"
+	HotplugCloudInit HotplugFeature = "cloudinit"
+
+	// HotplugDefaults is the '1' alias for the default 'network,disk,usb' features.
+	HotplugDefaults HotplugFeature = "1"
+)
+
+// HotplugSet is the list of enabled hotplug features. An empty, non-nil set disables hotplug completely.
+type HotplugSet []HotplugFeature
+
+func NewHotplugSetFromString(value string) HotplugSet {
+	d := HotplugSet{}
+	if value == "0" {
+		return d
+	}
+	for _, feature := range strings.Split(value, ",") {
+		if feature != "" {
+			d = append(d, HotplugFeature(feature))
+		}
+	}
+	return d
+}
+
+// Has reports whether the hotplug feature f is enabled, resolving the '1' alias.
+func (c HotplugSet) Has(f HotplugFeature) bool {
+	for _, feature := range c {
+		if feature == f {
+			return true
+		}
+		if feature == HotplugDefaults && (f == HotplugNetwork || f == HotplugDisk || f == HotplugUSB) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c HotplugSet) GetQMOptionValue() string {
+	if len(c) == 0 {
+		return "0"
+	}
+	v := make([]string, len(c))
+	for i, feature := range c {
+		v[i] = string(feature)
+	}
+	return strings.Join(v, ",")
+}
+
+// cloud-init IP configuration of a network device
+type IPConfig struct {
+	// IPv4 address in CIDR format or 'dhcp'.
+	IP *string `json:"ip,omitempty"`
+
+	// Default gateway for IPv4 traffic.
+	Gateway *string `json:"gw,omitempty"`
+
+	// IPv6 address in CIDR format, 'dhcp' or 'auto'.
+	IP6 *string `json:"ip6,omitempty"`
+
+	// Default gateway for IPv6 traffic.
+	Gateway6 *string `json:"gw6,omitempty"`
+}
+
+func NewIPConfigFromString(value string) *IPConfig {
+	d := &IPConfig{}
+	for k, v := range parseQMOptionValue(value, "") {
+		switch k {
+		case "ip":
+			d.IP = String(v)
+		case "gw":
+			d.Gateway = String(v)
+		case "ip6":
+			d.IP6 = String(v)
+		case "gw6":
+			d.Gateway6 = String(v)
+		}
+	}
+	return d
+}
+
+func (c *IPConfig) GetQMOptionValue() string {
+	v := make([]string, 0, 1)
+	if c.Gateway != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "gw", *c.Gateway))
+	}
+	if c.Gateway6 != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "gw6", *c.Gateway6))
+	}
+	if c.IP != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "ip", *c.IP))
+	}
+	if c.IP6 != nil {
+		v = append(v, fmt.Sprintf("%s=%s", "ip6", *c.IP6))
+	}
+	return strings.Join(v, ",")
+}
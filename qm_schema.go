@@ -0,0 +1,112 @@
+package goproxmox
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+)
+
+// knownValuer is implemented by the generated enum types.
+type knownValuer interface {
+	knownValues() []string
+}
+
+var (
+	knownValuerType   = reflect.TypeOf((*knownValuer)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// VMConfigJSONSchema returns the JSON Schema (draft-07) of the JSON representation of VMConfig.
+// Enums list the values known to this library. The published vmconfig.schema.json is generated from it
+// by gen_schema.go and additionally carries the field descriptions.
+func VMConfigJSONSchema() map[string]interface{} {
+	properties := make(map[string]interface{})
+	patternProperties := make(map[string]interface{})
+
+	t := reflect.TypeOf(VMConfig{})
+	for _, f := range vmConfigJSONFields() {
+		fieldType := t.Field(f.Index).Type
+		if !f.Indexed {
+			properties[f.Name] = jsonSchemaOf(fieldType)
+			continue
+		}
+		schema := jsonSchemaOf(fieldType.Elem())
+		if fieldType.Elem().Kind() == reflect.Interface {
+			schema = rawQMOptionJSONSchema(f.Name)
+		}
+		patternProperties["^"+f.Name+`\d+$`] = schema
+	}
+
+	return map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"title":                "VMConfig",
+		"description":          "Configuration of a Proxmox VE QEMU virtual machine. Options unknown to this library are strings.",
+		"type":                 "object",
+		"properties":           properties,
+		"patternProperties":    patternProperties,
+		"additionalProperties": map[string]interface{}{"type": "string"},
+	}
+}
+
+func rawQMOptionJSONSchema(name string) map[string]interface{} {
+	if _, ok := rawQMOptionDefaultKeys[name]; !ok {
+		return map[string]interface{}{"type": "string"}
+	}
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": map[string]interface{}{"type": "string"},
+	}
+}
+
+func jsonSchemaOf(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Implements(knownValuerType) {
+		values := reflect.Zero(t).Interface().(knownValuer).knownValues()
+		return map[string]interface{}{"type": "string", "enum": values}
+	}
+	if t.Implements(textMarshalerType) {
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": jsonSchemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": jsonSchemaOf(t.Elem())}
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		var additionalProperties interface{} = false
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				// unmodelled options are flattened into the object
+				if field.Type.Kind() == reflect.Map {
+					additionalProperties = map[string]interface{}{"type": "string"}
+				}
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			properties[name] = jsonSchemaOf(field.Type)
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": additionalProperties,
+		}
+	}
+	return map[string]interface{}{}
+}
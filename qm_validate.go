@@ -0,0 +1,385 @@
+package goproxmox
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	macAddrRegexp   = regexp.MustCompile(`^[0-9a-fA-F]{2}(?::[0-9a-fA-F]{2}){5}$`)
+	vlanRangeRegexp = regexp.MustCompile(`^(\d+)(?:-(\d+))?$`)
+)
+
+// Highest device index of the device maps of VMConfig, e.g. ide0 to ide3.
+var deviceIndexLimits = map[string]int{
+	parameterIDEDevices:      3,
+	parameterIPConfigs:       31,
+	parameterNetworkDevices:  31,
+	parameterNUMATopologies:  7,
+	parameterParallelDevices: 2,
+	parameterSATADevices:     5,
+	parameterSCSIDevices:     30,
+	parameterSerialDevices:   3,
+	parameterUSBDevices:      4,
+	parameterVirtIODevices:   15,
+}
+
+var (
+	// bootdisk must be one of the disk buses
+	bootDiskRegexp = regexp.MustCompile(`^(?:ide|sata|scsi|virtio)\d+$`)
+	// the boot order ("order=scsi0;net0") may also refer to network and passed through devices
+	bootDeviceRegexp = regexp.MustCompile(`^(?:ide|sata|scsi|virtio|net|hostpci|usb)\d+$`)
+)
+
+// validator collects the problems found by Validate.
+type validator struct {
+	errors ValidationErrors
+}
+
+func (v *validator) add(arg, format string, a ...interface{}) {
+	v.errors = append(v.errors, NewArgError(arg, fmt.Sprintf(format, a...)))
+}
+
+func (v *validator) intRange(arg string, value *int, min, max int) {
+	if value != nil && (*value < min || *value > max) {
+		v.add(arg, "it must be %d to %d", min, max)
+	}
+}
+
+func (v *validator) intMin(arg string, value *int, min int) {
+	if value != nil && *value < min {
+		v.add(arg, "it must be >= %d", min)
+	}
+}
+
+// Validate checks the ranges and formats of all options and their relationships, like the balloon target
+// not exceeding the memory or the boot disk existing. Unlike GetOptionsMap it doesn't stop at the first problem,
+// it returns all of them as ValidationErrors, or nil if the config is valid.
+func (c *VMConfig) Validate() error {
+	v := new(validator)
+
+	c.validateCPUAndMemory(v)
+	c.validateDeviceIndexes(v)
+	c.validateNetworkDevices(v)
+	c.validateBoot(v)
+	c.validateCloudInit(v)
+
+	v.intMin(parameterMigrateDowntime, c.MigrateDowntime, 0)
+	v.intMin(parameterMigrateSpeed, c.MigrateSpeed, 0)
+	v.intRange(parameterVMID, c.VMID, 100, 999999999)
+	if c.IVShmem != nil {
+		v.intMin(parameterIVShmem+".size", c.IVShmem.Size, 1)
+	}
+	if c.MachineType != nil && c.MachineType.VIOMMU != nil && *c.MachineType.VIOMMU == VIOMMU_Intel && !c.MachineType.IsQ35() {
+		v.add(parameterMachineType+".viommu", "the intel vIOMMU requires a q35 machine type")
+	}
+	if c.RNG != nil {
+		v.intMin(parameterRNG+".max_bytes", c.RNG.MaxBytes, 0)
+		v.intMin(parameterRNG+".period", c.RNG.Period, 0)
+	}
+	if c.Startup != nil {
+		v.intMin(parameterStartup+".order", c.Startup.Order, 0)
+		v.intMin(parameterStartup+".up", c.Startup.Up, 0)
+		v.intMin(parameterStartup+".down", c.Startup.Down, 0)
+	}
+
+	if len(c.Delete) > 0 {
+		keys := c.setKeys()
+		for _, key := range c.Delete {
+			if keys[key] {
+				v.add(parameterDelete, "%s can't be set and deleted at the same time", key)
+			}
+		}
+	}
+
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return v.errors
+}
+
+func (c *VMConfig) validateCPUAndMemory(v *validator) {
+	v.intMin(parameterCores, c.Cores, 1)
+	v.intMin(parameterSockets, c.Sockets, 1)
+	v.intMin(parameterSMP, c.SMP, 1)
+	v.intRange(parameterCPULimit, c.CPULimit, 0, 128)
+	v.intRange(parameterCPUUnits, c.CPUUnits, 0, 500000)
+	if c.VCPUs != nil {
+		cores, sockets := 1, 1
+		if c.Cores != nil {
+			cores = *c.Cores
+		}
+		if c.Sockets != nil {
+			sockets = *c.Sockets
+		}
+		if cores > 0 && sockets > 0 {
+			v.intRange(parameterVCPUs, c.VCPUs, 1, cores*sockets)
+		}
+	}
+
+	v.intMin(parameterMemory, c.Memory, 16)
+	v.intRange(parameterMemoryShares, c.MemoryShares, 0, 50000)
+	if c.Balloon != nil {
+		memory := 512
+		if c.Memory != nil {
+			memory = *c.Memory
+		}
+		if *c.Balloon < 0 {
+			v.add(parameterBalloon, "it can't be < 0")
+		} else if *c.Balloon > memory {
+			v.add(parameterBalloon, "it can't be greater than memory (%d)", memory)
+		}
+		if *c.Balloon == 0 && c.MemoryShares != nil && *c.MemoryShares != 0 {
+			v.add(parameterMemoryShares, "it has no effect when the balloon device is disabled")
+		}
+	}
+}
+
+func (c *VMConfig) validateDeviceIndexes(v *validator) {
+	devices := map[string]interface{}{
+		parameterIDEDevices:      c.IDEDevices,
+		parameterIPConfigs:       c.IPConfigs,
+		parameterNetworkDevices:  c.NetworkDevices,
+		parameterNUMATopologies:  c.NUMATopologies,
+		parameterParallelDevices: c.ParallelDevices,
+		parameterSATADevices:     c.SATADevices,
+		parameterSCSIDevices:     c.SCSIDevices,
+		parameterSerialDevices:   c.SerialDevices,
+		parameterUSBDevices:      c.USBDevices,
+		parameterVirtIODevices:   c.VirtIODevices,
+	}
+	for _, name := range sortedInterfaceKeys(devices) {
+		for _, number := range deviceNumbers(devices[name]) {
+			if number < 0 || number > deviceIndexLimits[name] {
+				v.add(fmt.Sprintf("%s%d", name, number), "the index must be 0 to %d", deviceIndexLimits[name])
+			}
+		}
+	}
+}
+
+func (c *VMConfig) validateNetworkDevices(v *validator) {
+	for _, number := range deviceNumbers(c.NetworkDevices) {
+		d := c.NetworkDevices[number]
+		key := fmt.Sprintf("%s%d", parameterNetworkDevices, number)
+		if d == nil {
+			v.add(key, "it must not be nil")
+			continue
+		}
+		if d.Model == nil {
+			v.add(key+".model", "it is required")
+		}
+		if d.MacAddr != nil {
+			if !macAddrRegexp.MatchString(*d.MacAddr) {
+				v.add(key+".macaddr", "%q is not a MAC address (XX:XX:XX:XX:XX:XX)", *d.MacAddr)
+			} else if firstOctet, _ := strconv.ParseUint((*d.MacAddr)[:2], 16, 8); firstOctet&1 == 1 {
+				v.add(key+".macaddr", "%s is a multicast address", *d.MacAddr)
+			}
+		}
+		v.intRange(key+".tag", d.Tag, 1, 4094)
+		v.intRange(key+".queues", d.Queues, 0, 64)
+		if d.Rate != nil && *d.Rate < 0 {
+			v.add(key+".rate", "it must be >= 0")
+		}
+		if d.Trunks != nil {
+			for _, trunk := range strings.Split(*d.Trunks, ";") {
+				if !isValidVLANRange(trunk) {
+					v.add(key+".trunks", "%q is not a VLAN ID or range of 1 to 4094", trunk)
+				}
+			}
+		}
+	}
+}
+
+func isValidVLANRange(value string) bool {
+	matchResults := vlanRangeRegexp.FindStringSubmatch(value)
+	if matchResults == nil {
+		return false
+	}
+	from, _ := strconv.Atoi(matchResults[1])
+	to := from
+	if matchResults[2] != "" {
+		to, _ = strconv.Atoi(matchResults[2])
+	}
+	return from >= 1 && to <= 4094 && from <= to
+}
+
+func (c *VMConfig) validateBoot(v *validator) {
+	if len(c.BootOrder) > 4 {
+		v.add(parameterBootOrder, "there are too many boot devices specified")
+	}
+	devices := c.deviceKeys()
+	if c.BootDisk != nil {
+		if !bootDiskRegexp.MatchString(*c.BootDisk) {
+			v.add(parameterBootDisk, "%q is not a disk", *c.BootDisk)
+		} else if !devices[*c.BootDisk] {
+			v.add(parameterBootDisk, "disk %s doesn't exist", *c.BootDisk)
+		}
+	}
+	if boot, ok := c.Unknown[parameterBootOrder]; ok {
+		for _, device := range strings.Split(parseQMOptionValue(boot, "legacy")["order"], ";") {
+			if device == "" {
+				continue
+			}
+			if !bootDeviceRegexp.MatchString(device) {
+				v.add(parameterBootOrder+".order", "%q is not a bootable device", device)
+			} else if !devices[device] {
+				v.add(parameterBootOrder+".order", "device %s doesn't exist", device)
+			}
+		}
+	}
+}
+
+// deviceKeys returns the keys of all configured devices, e.g. "scsi0" or "net1".
+func (c *VMConfig) deviceKeys() map[string]bool {
+	keys := make(map[string]bool)
+	devices := map[string]interface{}{
+		parameterIDEDevices:      c.IDEDevices,
+		parameterNetworkDevices:  c.NetworkDevices,
+		parameterSATADevices:     c.SATADevices,
+		parameterSCSIDevices:     c.SCSIDevices,
+		parameterUSBDevices:      c.USBDevices,
+		parameterVirtIODevices:   c.VirtIODevices,
+		parameterSerialDevices:   c.SerialDevices,
+		parameterParallelDevices: c.ParallelDevices,
+	}
+	for name, m := range devices {
+		for _, number := range deviceNumbers(m) {
+			keys[fmt.Sprintf("%s%d", name, number)] = true
+		}
+	}
+	// e.g. hostpci0, which is not modelled yet
+	for key := range c.Unknown {
+		keys[key] = true
+	}
+	return keys
+}
+
+// setKeys returns the Proxmox names of all options that are set.
+func (c *VMConfig) setKeys() map[string]bool {
+	keys := make(map[string]bool)
+	s := reflect.ValueOf(c).Elem()
+	for _, f := range vmConfigJSONFields() {
+		field := s.Field(f.Index)
+		if field.IsNil() || f.Name == parameterDelete {
+			continue
+		}
+		if !f.Indexed {
+			keys[f.Name] = true
+			continue
+		}
+		for _, number := range field.MapKeys() {
+			keys[fmt.Sprintf("%s%d", f.Name, number.Int())] = true
+		}
+	}
+	return keys
+}
+
+// cloudInitDrive returns the key of the cloud-init drive, or "" if there is none.
+func (c *VMConfig) cloudInitDrive() string {
+	drives := make(map[string]string)
+	for number, d := range c.IDEDevices {
+		drives[fmt.Sprintf("%s%d", parameterIDEDevices, number)] = d.GetQMOptionValue()
+	}
+	for number, d := range c.SATADevices {
+		drives[fmt.Sprintf("%s%d", parameterSATADevices, number)] = d.GetQMOptionValue()
+	}
+	for number, d := range c.SCSIDevices {
+		drives[fmt.Sprintf("%s%d", parameterSCSIDevices, number)] = d.GetQMOptionValue()
+	}
+	for _, key := range sortedKeys(drives) {
+		if strings.Contains(parseQMOptionValue(drives[key], "file")["file"], "cloudinit") {
+			return key
+		}
+	}
+	return ""
+}
+
+func (c *VMConfig) validateCloudInit(v *validator) {
+	configured := c.CICustom != nil || c.CIPassword != nil || c.CIType != nil || c.CIUpgrade != nil || c.CIUser != nil ||
+		c.Nameserver != nil || c.SearchDomain != nil || c.SSHKeys != nil || len(c.IPConfigs) > 0
+	if configured && c.cloudInitDrive() == "" {
+		v.add("cloudinit", "cloud-init options are set but there is no cloud-init drive")
+	}
+
+	for _, number := range deviceNumbers(c.IPConfigs) {
+		d := c.IPConfigs[number]
+		key := fmt.Sprintf("%s%d", parameterIPConfigs, number)
+		if d == nil {
+			v.add(key, "it must not be nil")
+			continue
+		}
+		if _, ok := c.NetworkDevices[number]; !ok {
+			v.add(key, "network device %s%d doesn't exist", parameterNetworkDevices, number)
+		}
+		if d.IP != nil && *d.IP != "dhcp" && !isCIDR(*d.IP, false) {
+			v.add(key+".ip", "%q is neither 'dhcp' nor an IPv4 address in CIDR format", *d.IP)
+		}
+		if d.Gateway != nil {
+			if ip := net.ParseIP(*d.Gateway); ip == nil || ip.To4() == nil {
+				v.add(key+".gw", "%q is not an IPv4 address", *d.Gateway)
+			} else if d.IP == nil || *d.IP == "dhcp" {
+				v.add(key+".gw", "a gateway requires a static IPv4 address")
+			}
+		}
+		if d.IP6 != nil && *d.IP6 != "dhcp" && *d.IP6 != "auto" && !isCIDR(*d.IP6, true) {
+			v.add(key+".ip6", "%q is neither 'dhcp', 'auto' nor an IPv6 address in CIDR format", *d.IP6)
+		}
+		if d.Gateway6 != nil {
+			if ip := net.ParseIP(*d.Gateway6); ip == nil || ip.To4() != nil {
+				v.add(key+".gw6", "%q is not an IPv6 address", *d.Gateway6)
+			} else if d.IP6 == nil || *d.IP6 == "dhcp" || *d.IP6 == "auto" {
+				v.add(key+".gw6", "a gateway requires a static IPv6 address")
+			}
+		}
+	}
+
+	if c.Nameserver != nil {
+		for _, nameserver := range strings.FieldsFunc(*c.Nameserver, func(r rune) bool { return r == ' ' || r == ',' || r == ';' }) {
+			if net.ParseIP(nameserver) == nil {
+				v.add(parameterNameserver, "%q is not an IP address", nameserver)
+			}
+		}
+	}
+	if c.CICustom != nil {
+		for key := range parseQMOptionValue(*c.CICustom, "") {
+			switch key {
+			case "meta", "network", "user", "vendor":
+			default:
+				v.add(parameterCICustom, "%q is not a cloud-init file type", key)
+			}
+		}
+	}
+}
+
+func isCIDR(value string, ipv6 bool) bool {
+	ip, _, err := net.ParseCIDR(value)
+	if err != nil {
+		return false
+	}
+	return (ip.To4() == nil) == ipv6
+}
+
+// deviceNumbers returns the sorted keys of a device map like map[int]*NetworkDevice.
+func deviceNumbers(m interface{}) []int {
+	value := reflect.ValueOf(m)
+	numbers := make([]int, 0, value.Len())
+	for _, key := range value.MapKeys() {
+		numbers = append(numbers, int(key.Int()))
+	}
+	sort.Ints(numbers)
+	return numbers
+}
+
+func sortedInterfaceKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
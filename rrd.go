@@ -0,0 +1,184 @@
+package goproxmox
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+)
+
+// VMRRDPoint is a sample of the performance data of a VM. Values are nil where nothing was recorded, e.g.
+// while the VM was stopped.
+type VMRRDPoint struct {
+	Time time.Time
+
+	CPU     *float64 // Fraction of MaxCPU in use
+	MaxCPU  *float64
+	Mem     *float64 // Bytes
+	MaxMem  *float64
+	Disk    *float64 // Bytes
+	MaxDisk *float64
+
+	// Bytes per second
+	NetIn     *float64
+	NetOut    *float64
+	DiskRead  *float64
+	DiskWrite *float64
+}
+
+// NodeRRDPoint is a sample of the performance data of a node. Values are nil where nothing was recorded.
+type NodeRRDPoint struct {
+	Time time.Time
+
+	CPU     *float64 // Fraction of MaxCPU in use
+	MaxCPU  *float64
+	IOWait  *float64 // Fraction of the CPU time spent waiting for I/O
+	LoadAvg *float64
+
+	// Bytes
+	MemUsed   *float64
+	MemTotal  *float64
+	SwapUsed  *float64
+	SwapTotal *float64
+	RootUsed  *float64
+	RootTotal *float64
+
+	// Bytes per second
+	NetIn  *float64
+	NetOut *float64
+}
+
+// StorageRRDPoint is a sample of the usage of a storage in bytes. Values are nil where nothing was recorded.
+type StorageRRDPoint struct {
+	Time time.Time
+
+	Used  *float64
+	Total *float64
+}
+
+// Get the performance data of the VM over timeframe, one point per step of the RRD (e.g. a minute for
+// an hour, 30 minutes for a week). cf defaults to RRDConsolidation_Average.
+func (s *QemuServiceOp) GetVMRRDData(ctx context.Context, node string, vmID int, timeframe RRDTimeframe, cf RRDConsolidation) ([]VMRRDPoint, error) {
+	var params *GetNodesQemuRRDDataParams
+	if cf != "" {
+		consolidation := GetNodesQemuRRDDataCF(cf)
+		params = &GetNodesQemuRRDDataParams{CF: &consolidation}
+	}
+	samples, err := s.client.API.GetNodesQemuRRDData(ctx, node, vmID, GetNodesQemuRRDDataTimeframe(timeframe), params)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]VMRRDPoint, len(samples))
+	for i, sample := range samples {
+		point := &points[i]
+		point.Time = rrdTime(sample)
+		rrdValues(sample, map[string]**float64{
+			"cpu":       &point.CPU,
+			"maxcpu":    &point.MaxCPU,
+			"mem":       &point.Mem,
+			"maxmem":    &point.MaxMem,
+			"disk":      &point.Disk,
+			"maxdisk":   &point.MaxDisk,
+			"netin":     &point.NetIn,
+			"netout":    &point.NetOut,
+			"diskread":  &point.DiskRead,
+			"diskwrite": &point.DiskWrite,
+		})
+	}
+	return points, nil
+}
+
+// Get the performance data of the node over timeframe. cf defaults to RRDConsolidation_Average.
+func (s *NodesServiceOp) GetNodeRRDData(ctx context.Context, node string, timeframe RRDTimeframe, cf RRDConsolidation) ([]NodeRRDPoint, error) {
+	var params *GetNodesRRDDataParams
+	if cf != "" {
+		consolidation := GetNodesRRDDataCF(cf)
+		params = &GetNodesRRDDataParams{CF: &consolidation}
+	}
+	samples, err := s.client.API.GetNodesRRDData(ctx, node, GetNodesRRDDataTimeframe(timeframe), params)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]NodeRRDPoint, len(samples))
+	for i, sample := range samples {
+		point := &points[i]
+		point.Time = rrdTime(sample)
+		rrdValues(sample, map[string]**float64{
+			"cpu":       &point.CPU,
+			"maxcpu":    &point.MaxCPU,
+			"iowait":    &point.IOWait,
+			"loadavg":   &point.LoadAvg,
+			"memused":   &point.MemUsed,
+			"memtotal":  &point.MemTotal,
+			"swapused":  &point.SwapUsed,
+			"swaptotal": &point.SwapTotal,
+			"rootused":  &point.RootUsed,
+			"roottotal": &point.RootTotal,
+			"netin":     &point.NetIn,
+			"netout":    &point.NetOut,
+		})
+	}
+	return points, nil
+}
+
+// Get the usage of the storage on the node over timeframe. cf defaults to RRDConsolidation_Average.
+func (s *StorageServiceOp) GetStorageRRDData(ctx context.Context, node, storageName string, timeframe RRDTimeframe, cf RRDConsolidation) ([]StorageRRDPoint, error) {
+	var params *GetNodesStorageRRDDataParams
+	if cf != "" {
+		consolidation := GetNodesStorageRRDDataCF(cf)
+		params = &GetNodesStorageRRDDataParams{CF: &consolidation}
+	}
+	samples, err := s.client.API.GetNodesStorageRRDData(ctx, node, storageName, GetNodesStorageRRDDataTimeframe(timeframe), params)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]StorageRRDPoint, len(samples))
+	for i, sample := range samples {
+		point := &points[i]
+		point.Time = rrdTime(sample)
+		rrdValues(sample, map[string]**float64{
+			"used":  &point.Used,
+			"total": &point.Total,
+		})
+	}
+	return points, nil
+}
+
+func rrdTime(sample map[string]interface{}) time.Time {
+	if seconds := rrdValue(sample["time"]); seconds != nil {
+		return time.Unix(int64(*seconds), 0)
+	}
+	return time.Time{}
+}
+
+// rrdValues sets the fields to the values of their keys in sample.
+func rrdValues(sample map[string]interface{}, fields map[string]**float64) {
+	for key, field := range fields {
+		*field = rrdValue(sample[key])
+	}
+}
+
+// rrdValue converts a value of an RRD sample. PVE leaves out unknown values; NaN, null and empty strings
+// are unknown as well.
+func rrdValue(v interface{}) *float64 {
+	var f float64
+	switch value := v.(type) {
+	case float64:
+		f = value
+	case string:
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil
+		}
+		f = parsed
+	default:
+		return nil
+	}
+	if math.IsNaN(f) {
+		return nil
+	}
+	return &f
+}
@@ -0,0 +1,48 @@
+package goproxmox
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRRDValue(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  *float64
+	}{
+		{value: 0.25, want: Float64(0.25)},
+		{value: float64(0), want: Float64(0)},
+		{value: "1024", want: Float64(1024)},
+		{value: "1.5e3", want: Float64(1500)},
+		{value: math.NaN()},
+		{value: "NaN"},
+		{value: "nan"},
+		{value: ""},
+		{value: nil},
+		{value: true},
+		{value: "fast"},
+	}
+
+	for _, test := range tests {
+		got := rrdValue(test.value)
+		if (got == nil) != (test.want == nil) || got != nil && *got != *test.want {
+			t.Errorf("%#v: got %v, want %v", test.value, got, test.want)
+		}
+	}
+}
+
+func TestRRDSample(t *testing.T) {
+	var cpu, memory *float64
+	sample := map[string]interface{}{"time": float64(1700000000), "cpu": "0.5", "mem": "NaN"}
+	rrdValues(sample, map[string]**float64{"cpu": &cpu, "mem": &memory})
+	if cpu == nil || *cpu != 0.5 || memory != nil {
+		t.Errorf("cpu = %v, mem = %v", cpu, memory)
+	}
+	if tm := rrdTime(sample); !tm.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("time = %v", tm)
+	}
+	if tm := rrdTime(map[string]interface{}{}); !tm.IsZero() {
+		t.Errorf("time of a sample without time = %v, want zero", tm)
+	}
+}
@@ -0,0 +1,80 @@
+package goproxmox
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+)
+
+// SpiceConfigOptions overrides settings of the virt-viewer file generated by GenerateSpiceConfig. Empty
+// fields keep what PVE returned or the defaults of virt-viewer.
+type SpiceConfigOptions struct {
+	// Window title, PVE sets "VM <vmid> - <name>"
+	Title string
+
+	// Open the viewer in fullscreen mode
+	Fullscreen *bool
+
+	// Allow redirecting USB devices of the client to the VM
+	EnableUSBRedirection *bool
+
+	// Auto-redirect filter for USB devices in the usbredir filter format, e.g. "-1,-1,-1,-1,1" to allow all
+	USBFilter string
+
+	// Hotkeys in the virt-viewer format, e.g. "shift+f12"
+	ReleaseCursor    string
+	ToggleFullscreen string
+}
+
+func (o *SpiceConfigOptions) apply(settings SpiceProxyTicket) {
+	if o == nil {
+		return
+	}
+	set := func(key, value string) {
+		if value != "" {
+			settings[key] = value
+		}
+	}
+	set("title", o.Title)
+	set("usb-filter", o.USBFilter)
+	set("release-cursor", o.ReleaseCursor)
+	set("toggle-fullscreen", o.ToggleFullscreen)
+	if o.Fullscreen != nil {
+		settings["fullscreen"] = boolToString(*o.Fullscreen)
+	}
+	if o.EnableUSBRedirection != nil {
+		settings["enable-usbredir"] = boolToString(*o.EnableUSBRedirection)
+	}
+}
+
+// Get a SPICE ticket for the VM and render it as virt-viewer (.vv) file, ready to be opened with
+// remote-viewer. The file carries the ticket, so it is only valid for a short time and for a single
+// connection. proxy is passed on to SpiceProxy.
+func (s *QemuServiceOp) GenerateSpiceConfig(ctx context.Context, node string, vmID int, proxy string, options *SpiceConfigOptions) ([]byte, error) {
+	ticket, err := s.SpiceProxy(ctx, node, vmID, proxy)
+	if err != nil {
+		return nil, err
+	}
+	options.apply(ticket)
+	return ticket.virtViewerFile(), nil
+}
+
+// virtViewerFile renders the settings as INI file with a single [virt-viewer] section. Values must not span
+// lines, so newlines (in the CA certificate) are escaped as \n, which virt-viewer understands.
+func (t SpiceProxyTicket) virtViewerFile() []byte {
+	keys := make([]string, 0, len(t))
+	for key := range t {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString("[virt-viewer]\n")
+	for _, key := range keys {
+		value := strings.Replace(t[key], "\r", "", -1)
+		value = strings.Replace(value, "\n", `\n`, -1)
+		buf.WriteString(key + "=" + value + "\n")
+	}
+	return buf.Bytes()
+}
@@ -1,6 +1,7 @@
 package goproxmox
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"net/http"
@@ -12,6 +13,7 @@ type StorageService interface {
 	GetVolume(node, storageName, volumeId string) (*StorageVolume, error)
 	CreateVolume(node, storageName string, vmID int, filename string, size string, format *string) error
 	DeleteVolume(node, storageName, volumeId string) error
+	GetStorageRRDData(ctx context.Context, node, storageName string, timeframe RRDTimeframe, cf RRDConsolidation) ([]StorageRRDPoint, error)
 }
 
 type StorageServiceOp struct {
@@ -0,0 +1,133 @@
+package goproxmox
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const taskStatusRunning = "running"
+
+// UPID:node:pid:pstart:starttime:type:id:user: with the numbers in hex
+var upidRegexp = regexp.MustCompile(`^UPID:([a-zA-Z0-9]([a-zA-Z0-9\-]*[a-zA-Z0-9])?):([0-9A-Fa-f]{8}):([0-9A-Fa-f]{8,9}):([0-9A-Fa-f]{8}):([^:\s]+):([^:\s]*):([^:\s]+):$`)
+
+// Task is a handle on a worker task of PVE, e.g. started by StartAll or a migration. The fields are
+// decoded from the UPID, the unique ID of the task.
+type Task struct {
+	UPID      string
+	Node      string // Node the task runs on
+	PID       int
+	PStart    int64 // Start time of the process in clock ticks since boot
+	StartTime time.Time
+	Type      string // e.g. "startall" or "qmigrate"
+	ID        string // Object the task works on, e.g. the VMID. Empty for node wide tasks
+	User      string
+
+	client *Client
+}
+
+// TaskStatus is the status of a Task.
+type TaskStatus struct {
+	Running bool
+
+	// Set once the task stopped: "OK", "WARNINGS: <count>" or the error message
+	ExitStatus string
+}
+
+// Succeeded reports whether the task stopped without error. Warnings don't count as error.
+func (s *TaskStatus) Succeeded() bool {
+	return !s.Running && (s.ExitStatus == "OK" || strings.HasPrefix(s.ExitStatus, "WARNINGS"))
+}
+
+// TaskFailedError is returned by Task.Wait when the task stopped with an error.
+type TaskFailedError struct {
+	UPID       string
+	Type       string
+	ID         string
+	ExitStatus string
+}
+
+func (e *TaskFailedError) Error() string {
+	if e.ID != "" {
+		return fmt.Sprintf("task %s of %s failed: %s", e.Type, e.ID, e.ExitStatus)
+	}
+	return fmt.Sprintf("task %s failed: %s", e.Type, e.ExitStatus)
+}
+
+// TaskTimeoutError is returned when the timeout of WaitConfig passes before the task stopped.
+type TaskTimeoutError struct {
+	UPID    string
+	Timeout time.Duration
+}
+
+func (e *TaskTimeoutError) Error() string {
+	return fmt.Sprintf("task %s did not stop within %s", e.UPID, e.Timeout)
+}
+
+// Task returns a handle on the task with the given UPID, e.g. one returned by a generated API method.
+func (c *Client) Task(upid string) (*Task, error) {
+	match := upidRegexp.FindStringSubmatch(upid)
+	if match == nil {
+		return nil, NewArgError("upid", fmt.Sprintf("%q is not a valid UPID", upid))
+	}
+	pid, _ := strconv.ParseInt(match[3], 16, 64)
+	pstart, _ := strconv.ParseInt(match[4], 16, 64)
+	startTime, _ := strconv.ParseInt(match[5], 16, 64)
+	return &Task{
+		UPID:      upid,
+		Node:      match[1],
+		PID:       int(pid),
+		PStart:    pstart,
+		StartTime: time.Unix(startTime, 0),
+		Type:      match[6],
+		ID:        match[7],
+		User:      match[8],
+		client:    c,
+	}, nil
+}
+
+// Get the status of the task.
+func (t *Task) Status(ctx context.Context) (*TaskStatus, error) {
+	response, err := t.client.API.GetNodesTasksStatus(ctx, t.Node, t.UPID)
+	if err != nil {
+		return nil, err
+	}
+	return &TaskStatus{
+		Running:    response.Status == taskStatusRunning,
+		ExitStatus: response.ExitStatus,
+	}, nil
+}
+
+// Poll the status of the task until it stopped and return the final status. A task that stopped with an
+// error yields a TaskFailedError. The wait ends with a TaskTimeoutError after config.Timeout, or with the
+// error of ctx when ctx is done; the task keeps running in both cases.
+func (t *Task) Wait(ctx context.Context, config *WaitConfig) (*TaskStatus, error) {
+	var status *TaskStatus
+	err := poll(ctx, config, func() (done bool, err error) {
+		if status, err = t.Status(ctx); err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			return false, err
+		}
+		return !status.Running, nil
+	})
+	if err == errPollTimeout {
+		return status, &TaskTimeoutError{UPID: t.UPID, Timeout: config.withDefaults().Timeout}
+	}
+	if err != nil {
+		return status, err
+	}
+	if !status.Succeeded() {
+		return status, &TaskFailedError{UPID: t.UPID, Type: t.Type, ID: t.ID, ExitStatus: status.ExitStatus}
+	}
+	return status, nil
+}
+
+// Stop the task. Bulk tasks like StopAll stop starting further work, the work already started continues.
+func (t *Task) Stop(ctx context.Context) error {
+	return t.client.API.DeleteNodesTasksUPID(ctx, t.Node, t.UPID)
+}